@@ -0,0 +1,303 @@
+package termhyo
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Color is a terminal color: one of the 16 basic ANSI colors, a 256-color palette index,
+// or a 24-bit RGB value. The zero value is ColorDefault, the terminal's default color.
+type Color uint32
+
+// Kinds of colors, stored in the high byte of a Color.
+const (
+	colorANSI     Color = 1 << 24
+	colorPalette  Color = 2 << 24
+	colorRGB      Color = 3 << 24
+	colorKindMask Color = 0xff << 24
+)
+
+// The default color and the 16 basic ANSI colors.
+const (
+	ColorDefault Color = 0
+
+	ColorBlack Color = colorANSI + iota - 1
+	ColorRed
+	ColorGreen
+	ColorYellow
+	ColorBlue
+	ColorMagenta
+	ColorCyan
+	ColorWhite
+	ColorBrightBlack
+	ColorBrightRed
+	ColorBrightGreen
+	ColorBrightYellow
+	ColorBrightBlue
+	ColorBrightMagenta
+	ColorBrightCyan
+	ColorBrightWhite
+)
+
+// colorNames are the names of the 16 basic ANSI colors, by color number.
+var colorNames = [16]string{
+	"black", "red", "green", "yellow", "blue", "magenta", "cyan", "white",
+	"bright_black", "bright_red", "bright_green", "bright_yellow",
+	"bright_blue", "bright_magenta", "bright_cyan", "bright_white",
+}
+
+// PaletteColor returns the color of a 256-color palette index.
+func PaletteColor(index uint8) Color {
+	return colorPalette | Color(index)
+}
+
+// RGBColor returns a 24-bit color.
+func RGBColor(r, g, b uint8) Color {
+	return colorRGB | Color(r)<<16 | Color(g)<<8 | Color(b)
+}
+
+// ParseColor parses a color given as a name such as "red" or "bright_blue", "#rrggbb",
+// a 256-color index such as "208", or an escape sequence such as AnsiRed or the result of RGB256.
+// An empty string and "default" are ColorDefault.
+func ParseColor(s string) (Color, error) {
+	if strings.HasPrefix(s, "\x1b[") {
+		if params, ok := sgrParams(s); ok {
+			if c, _, n, ok := parseSGRColor(params, 0); ok && n == len(params) {
+				return c, nil
+			}
+		}
+		return ColorDefault, fmt.Errorf("invalid color sequence %q", s)
+	}
+
+	name := strings.ToLower(strings.TrimSpace(s))
+	if name == "" || name == "default" {
+		return ColorDefault, nil
+	}
+	if len(name) == 7 && name[0] == '#' {
+		if rgb, err := strconv.ParseUint(name[1:], 16, 32); err == nil {
+			return RGBColor(uint8(rgb>>16), uint8(rgb>>8), uint8(rgb)), nil
+		}
+	}
+	if index, err := strconv.Atoi(name); err == nil {
+		if index < 0 || index > 255 {
+			return ColorDefault, fmt.Errorf("256-color index %d out of range", index)
+		}
+		return PaletteColor(uint8(index)), nil
+	}
+	name = strings.ReplaceAll(name, "-", "_")
+	if name == "gray" || name == "grey" {
+		return ColorBrightBlack, nil
+	}
+	for n, colorName := range colorNames {
+		if name == colorName {
+			return ColorBlack + Color(n), nil
+		}
+	}
+	return ColorDefault, fmt.Errorf("unknown color %q", s)
+}
+
+// String returns the name of a basic color, the palette index, or "#rrggbb".
+func (c Color) String() string {
+	switch c & colorKindMask {
+	case colorANSI:
+		return colorNames[c&0xff]
+	case colorPalette:
+		return strconv.Itoa(int(c & 0xff))
+	case colorRGB:
+		return fmt.Sprintf("#%06x", uint32(c&0xffffff))
+	default:
+		return "default"
+	}
+}
+
+// RGB returns the RGB value of the color.
+// Basic and palette colors use the xterm palette; ColorDefault is black.
+func (c Color) RGB() (r, g, b uint8) {
+	var rgb [3]int
+	switch c & colorKindMask {
+	case colorANSI, colorPalette:
+		rgb = paletteRGB(int(c & 0xff))
+	case colorRGB:
+		return uint8(c >> 16), uint8(c >> 8), uint8(c)
+	}
+	return uint8(rgb[0]), uint8(rgb[1]), uint8(rgb[2])
+}
+
+// Fg returns the escape sequence setting the color as the foreground color.
+func (c Color) Fg() string {
+	return c.sequence(false)
+}
+
+// Bg returns the escape sequence setting the color as the background color.
+func (c Color) Bg() string {
+	return c.sequence(true)
+}
+
+// sequence returns the escape sequence of the color, or an empty string for ColorDefault.
+func (c Color) sequence(background bool) string {
+	params := c.params(background)
+	if len(params) == 0 {
+		return ""
+	}
+	return "\x1b[" + strings.Join(params, ";") + "m"
+}
+
+// params returns the SGR parameters of the color.
+func (c Color) params(background bool) []string {
+	base := 38
+	if background {
+		base = 48
+	}
+	switch c & colorKindMask {
+	case colorANSI:
+		n := int(c & 0xff)
+		code := 30 + n
+		if n >= 8 {
+			code = 90 + n - 8
+		}
+		if background {
+			code += 10
+		}
+		return []string{strconv.Itoa(code)}
+	case colorPalette:
+		return []string{strconv.Itoa(base), "5", strconv.Itoa(int(c & 0xff))}
+	case colorRGB:
+		r, g, b := c.RGB()
+		return []string{strconv.Itoa(base), "2", strconv.Itoa(int(r)), strconv.Itoa(int(g)), strconv.Itoa(int(b))}
+	default:
+		return nil
+	}
+}
+
+// convert returns the nearest color supported by the color profile.
+func (c Color) convert(profile ColorProfile) Color {
+	kind := c & colorKindMask
+	switch {
+	case c == ColorDefault || profile == ProfileTrueColor:
+		return c
+	case profile == ProfileNoColor:
+		return ColorDefault
+	case profile == ProfileANSI256 && kind == colorRGB:
+		r, g, b := c.RGB()
+		return PaletteColor(uint8(nearestIndex([3]int{int(r), int(g), int(b)}, 16, 256)))
+	case profile == ProfileANSI16 && kind == colorPalette && c&0xff < 16:
+		return ColorBlack + c&0xff
+	case profile == ProfileANSI16 && kind != colorANSI:
+		r, g, b := c.RGB()
+		return ColorBlack + Color(nearestIndex([3]int{int(r), int(g), int(b)}, 0, 16))
+	default:
+		return c
+	}
+}
+
+// sgrParams returns the parameters of a single SGR sequence.
+func sgrParams(seq string) ([]string, bool) {
+	if !sgrRegex.MatchString(seq) || sgrRegex.FindString(seq) != seq {
+		return nil, false
+	}
+	return strings.Split(seq[2:len(seq)-1], ";"), true
+}
+
+// parseSGRColor parses a color from the SGR parameters starting at index i.
+// It returns the color, whether it is a background color, and the index after its parameters.
+func parseSGRColor(params []string, i int) (c Color, background bool, next int, ok bool) {
+	code, err := strconv.Atoi(params[i])
+	if err != nil {
+		return ColorDefault, false, i + 1, false
+	}
+	switch {
+	case code >= 30 && code <= 37, code >= 40 && code <= 47:
+		return ColorBlack + Color(code%10), code >= 40, i + 1, true
+	case code >= 90 && code <= 97, code >= 100 && code <= 107:
+		return ColorBrightBlack + Color(code%10), code >= 100, i + 1, true
+	case (code == 38 || code == 48) && i+2 < len(params) && params[i+1] == "5":
+		index, err := strconv.Atoi(params[i+2])
+		if err != nil || index < 0 || index > 255 {
+			return ColorDefault, false, i + 3, false
+		}
+		return PaletteColor(uint8(index)), code == 48, i + 3, true
+	case (code == 38 || code == 48) && i+4 < len(params) && params[i+1] == "2":
+		var rgb [3]uint8
+		for j := range rgb {
+			v, err := strconv.Atoi(params[i+2+j])
+			if err != nil || v < 0 || v > 255 {
+				return ColorDefault, false, i + 5, false
+			}
+			rgb[j] = uint8(v)
+		}
+		return RGBColor(rgb[0], rgb[1], rgb[2]), code == 48, i + 5, true
+	default:
+		return ColorDefault, false, i + 1, false
+	}
+}
+
+// convertSGR converts the colors of the SGR sequences in s to the color profile.
+// Other parameters, such as text attributes, are kept.
+func convertSGR(s string, profile ColorProfile) string {
+	if profile == ProfileTrueColor || !strings.Contains(s, "\x1b[") {
+		return s
+	}
+	return sgrRegex.ReplaceAllStringFunc(s, func(seq string) string {
+		params, _ := sgrParams(seq)
+		converted := make([]string, 0, len(params))
+		for i := 0; i < len(params); {
+			c, background, next, ok := parseSGRColor(params, i)
+			if ok {
+				converted = append(converted, c.convert(profile).params(background)...)
+			} else if profile != ProfileNoColor || (params[i] != "39" && params[i] != "49") {
+				converted = append(converted, params[i:next]...)
+			}
+			i = next
+		}
+		if len(converted) == 0 {
+			return ""
+		}
+		return "\x1b[" + strings.Join(converted, ";") + "m"
+	})
+}
+
+// ansiPalette holds the RGB values of the 16 basic ANSI colors, as in xterm.
+var ansiPalette = [16][3]int{
+	{0, 0, 0}, {205, 0, 0}, {0, 205, 0}, {205, 205, 0},
+	{0, 0, 238}, {205, 0, 205}, {0, 205, 205}, {229, 229, 229},
+	{127, 127, 127}, {255, 0, 0}, {0, 255, 0}, {255, 255, 0},
+	{92, 92, 255}, {255, 0, 255}, {0, 255, 255}, {255, 255, 255},
+}
+
+// cubeLevels are the intensities of the 6x6x6 color cube of the 256-color palette.
+var cubeLevels = [6]int{0, 95, 135, 175, 215, 255}
+
+// paletteRGB returns the RGB value of a 256-color palette index.
+func paletteRGB(index int) [3]int {
+	switch {
+	case index < 0 || index > 255:
+		return [3]int{}
+	case index < 16:
+		return ansiPalette[index]
+	case index < 232:
+		i := index - 16
+		return [3]int{cubeLevels[i/36], cubeLevels[i/6%6], cubeLevels[i%6]}
+	default:
+		gray := 8 + (index-232)*10
+		return [3]int{gray, gray, gray}
+	}
+}
+
+// nearestIndex returns the palette index from start to end nearest to the RGB value.
+// Conversions to 256 colors skip the 16 basic colors, whose values vary between terminals.
+func nearestIndex(rgb [3]int, start, end int) int {
+	best, bestDistance := start, -1
+	for index := start; index < end; index++ {
+		c := paletteRGB(index)
+		distance := 0
+		for j := range c {
+			d := c[j] - rgb[j]
+			distance += d * d
+		}
+		if bestDistance < 0 || distance < bestDistance {
+			best, bestDistance = index, distance
+		}
+	}
+	return best
+}
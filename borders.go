@@ -5,14 +5,15 @@ type BorderStyle string
 
 // TableBorderConfig holds border style configuration.
 type TableBorderConfig struct {
-	Chars    map[string]string
-	Top      bool // Show top border
-	Bottom   bool // Show bottom border
-	Middle   bool // Show middle separator between header and data
-	Left     bool // Show left border
-	Right    bool // Show right border
-	Vertical bool // Show internal vertical separators
-	Padding  bool // Add content padding
+	Chars        map[string]string
+	Top          bool // Show top border
+	Bottom       bool // Show bottom border
+	Middle       bool // Show middle separator between header and data
+	Left         bool // Show left border
+	Right        bool // Show right border
+	Vertical     bool // Show internal vertical separators
+	Padding      bool // Add content padding
+	RowSeparator bool // Show middle separator between every data row
 }
 
 const (
@@ -30,8 +31,20 @@ const (
 	VerticalBarStyle BorderStyle = "vertical_bar"
 	// MarkdownStyle uses Markdown table format.
 	MarkdownStyle BorderStyle = "markdown"
-	// TSVStyle uses tab separators only.
+	// TSVStyle writes tab-separated values with TSVRenderer.
 	TSVStyle BorderStyle = "tsv"
+	// CSVStyle writes comma-separated values with CSVRenderer.
+	CSVStyle BorderStyle = "csv"
+	// HTMLStyle writes an HTML table with HTMLRenderer.
+	HTMLStyle BorderStyle = "html"
+	// JSONStyle writes a JSON array of objects with JSONRenderer.
+	JSONStyle BorderStyle = "json"
+	// JSONLinesStyle writes JSON Lines with JSONLinesRenderer.
+	JSONLinesStyle BorderStyle = "jsonl"
+	// YAMLStyle writes a YAML sequence of mappings with YAMLRenderer.
+	YAMLStyle BorderStyle = "yaml"
+	// LaTeXStyle writes a LaTeX tabular environment with booktabs rules with LaTeXRenderer.
+	LaTeXStyle BorderStyle = "latex"
 )
 
 // Predefined border configurations.
@@ -219,6 +232,52 @@ var (
 		Vertical: true,
 		Padding:  false, // Disable padding for TSV format
 	}
+
+	csvConfig = TableBorderConfig{
+		Chars: map[string]string{
+			"horizontal":   "",
+			"vertical":     ",",
+			"cross":        "",
+			"top_left":     "",
+			"top_right":    "",
+			"bottom_left":  "",
+			"bottom_right": "",
+			"top_cross":    "",
+			"bottom_cross": "",
+			"left_cross":   "",
+			"right_cross":  "",
+		},
+		Top:      false,
+		Bottom:   false,
+		Middle:   false,
+		Left:     false,
+		Right:    false,
+		Vertical: true,
+		Padding:  false, // Disable padding for CSV format
+	}
+
+	latexConfig = TableBorderConfig{
+		Chars: map[string]string{
+			"horizontal":   "",
+			"vertical":     "",
+			"cross":        "",
+			"top_left":     "",
+			"top_right":    "",
+			"bottom_left":  "",
+			"bottom_right": "",
+			"top_cross":    "",
+			"bottom_cross": "",
+			"left_cross":   "",
+			"right_cross":  "",
+		},
+		Top:      true,
+		Bottom:   true,
+		Middle:   true,
+		Left:     false,
+		Right:    false,
+		Vertical: false,
+		Padding:  false,
+	}
 )
 
 // GetBorderConfig returns border configuration for the specified style.
@@ -238,6 +297,10 @@ func GetBorderConfig(style BorderStyle) TableBorderConfig {
 		return markdownConfig
 	case TSVStyle:
 		return tsvConfig
+	case CSVStyle:
+		return csvConfig
+	case LaTeXStyle:
+		return latexConfig
 	default: // BoxDrawingStyle
 		return boxDrawingConfig
 	}
@@ -0,0 +1,150 @@
+package termhyo
+
+import (
+	"io"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// ColorPolicy defines when styles are written to the output.
+type ColorPolicy int
+
+const (
+	// ColorAlways always writes styles (default).
+	ColorAlways ColorPolicy = iota
+	// ColorAuto writes styles only when the output is a terminal that accepts them.
+	// NO_COLOR disables styles and CLICOLOR_FORCE enables them,
+	// otherwise styles are disabled for TERM=dumb and for outputs that are not terminals.
+	ColorAuto
+	// ColorNever never writes styles, and removes the colors and attributes from the content.
+	ColorNever
+)
+
+// String returns the string representation of the ColorPolicy.
+func (p ColorPolicy) String() string {
+	switch p {
+	case ColorAlways:
+		return "always"
+	case ColorAuto:
+		return "auto"
+	case ColorNever:
+		return "never"
+	default:
+		return "unknown"
+	}
+}
+
+// sgrRegex matches SGR (Select Graphic Rendition) sequences, which set colors and attributes.
+var sgrRegex = regexp.MustCompile(`\x1b\[[0-9;:]*m`)
+
+// colorEnabled reports whether styles are written to the writer under the policy.
+func colorEnabled(policy ColorPolicy, writer io.Writer) bool {
+	switch policy {
+	case ColorNever:
+		return false
+	case ColorAuto:
+		if os.Getenv("NO_COLOR") != "" {
+			return false
+		}
+		if force := os.Getenv("CLICOLOR_FORCE"); force != "" && force != "0" {
+			return true
+		}
+		if os.Getenv("TERM") == "dumb" {
+			return false
+		}
+		return isTerminal(writer)
+	default:
+		return true
+	}
+}
+
+// outputContent returns content as written to the output:
+// without colors and attributes when styles are disabled, and with colors converted to the color profile.
+func (t *Table) outputContent(content string) string {
+	if t.colors {
+		return convertSGR(content, t.profile)
+	}
+	return sgrRegex.ReplaceAllString(content, "")
+}
+
+// ColorProfile defines the colors supported by the output.
+// Colors of styles and content are converted to the nearest supported color before writing.
+type ColorProfile int
+
+const (
+	// ProfileTrueColor supports 24-bit colors, so colors are written as they are (default).
+	ProfileTrueColor ColorProfile = iota
+	// ProfileANSI256 supports the 256-color palette.
+	ProfileANSI256
+	// ProfileANSI16 supports the 16 basic ANSI colors.
+	ProfileANSI16
+	// ProfileNoColor supports no colors; text attributes such as bold are kept.
+	ProfileNoColor
+)
+
+// String returns the string representation of the ColorProfile.
+func (p ColorProfile) String() string {
+	switch p {
+	case ProfileTrueColor:
+		return "truecolor"
+	case ProfileANSI256:
+		return "256"
+	case ProfileANSI16:
+		return "16"
+	case ProfileNoColor:
+		return "none"
+	default:
+		return "unknown"
+	}
+}
+
+// DetectColorProfile returns the color profile of the terminal from the COLORTERM and TERM environment variables.
+func DetectColorProfile() ColorProfile {
+	switch os.Getenv("COLORTERM") {
+	case "truecolor", "24bit":
+		return ProfileTrueColor
+	}
+	if os.Getenv("WT_SESSION") != "" {
+		return ProfileTrueColor // Windows Terminal
+	}
+
+	term := os.Getenv("TERM")
+	switch {
+	case term == "dumb":
+		return ProfileNoColor
+	case strings.Contains(term, "truecolor") || strings.Contains(term, "direct"):
+		return ProfileTrueColor
+	case strings.Contains(term, "256color"):
+		return ProfileANSI256
+	default:
+		return ProfileANSI16
+	}
+}
+
+// resolveColors resolves whether styles are written and the color profile of the output.
+// With ColorAuto, the profile is limited to the detected one.
+func (t *Table) resolveColors() {
+	t.colors = colorEnabled(t.colorPolicy, t.writer)
+	t.profile = t.colorProfile
+	if t.colorPolicy == ColorAuto {
+		t.profile = max(t.profile, DetectColorProfile())
+	}
+}
+
+// outputStyle returns the style as written to the output:
+// empty when styles are disabled, and with colors converted to the color profile.
+func (t *Table) outputStyle(style Style) Style {
+	if !t.colors {
+		return Style{}
+	}
+	if t.profile == ProfileTrueColor {
+		return style
+	}
+	style.Foreground = style.Foreground.convert(t.profile)
+	style.Background = style.Background.convert(t.profile)
+	style.ForegroundColor = convertSGR(style.ForegroundColor, t.profile)
+	style.BackgroundColor = convertSGR(style.BackgroundColor, t.profile)
+	style.CustomPrefix = convertSGR(style.CustomPrefix, t.profile)
+	return style
+}
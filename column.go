@@ -19,21 +19,105 @@ func (a Alignment) String() string {
 	return string(a)
 }
 
+// VerticalAlignment represents vertical alignment options for cells spanning several lines.
+type VerticalAlignment string
+
+const (
+	// AlignTop aligns content to the top (default).
+	AlignTop VerticalAlignment = ""
+	// AlignMiddle aligns content to the middle.
+	AlignMiddle VerticalAlignment = "middle"
+	// AlignBottom aligns content to the bottom.
+	AlignBottom VerticalAlignment = "bottom"
+)
+
+// String returns the string representation of the vertical alignment.
+func (v VerticalAlignment) String() string {
+	if v == AlignTop {
+		return "top"
+	}
+	return string(v)
+}
+
+// Overflow represents how content wider than the column is handled.
+type Overflow string
+
+const (
+	// OverflowTruncate cuts the end of the content and appends the ellipsis (default).
+	OverflowTruncate Overflow = ""
+	// OverflowTruncateMiddle cuts the middle of the content and replaces it by the ellipsis.
+	OverflowTruncateMiddle Overflow = "middle"
+	// OverflowTruncateStart cuts the start of the content and prepends the ellipsis.
+	OverflowTruncateStart Overflow = "start"
+	// OverflowWrap breaks the content into several lines at any character.
+	OverflowWrap Overflow = "wrap"
+	// OverflowWordWrap breaks the content into several lines at word boundaries.
+	OverflowWordWrap Overflow = "word"
+)
+
+// String returns the string representation of the overflow policy.
+func (o Overflow) String() string {
+	if o == OverflowTruncate {
+		return "truncate"
+	}
+	return string(o)
+}
+
+// ValueType represents the type of the values in a column, for data formats with typed values.
+type ValueType string
+
+const (
+	// TypeString writes the values as strings (default).
+	TypeString ValueType = ""
+	// TypeNumber writes the values as numbers.
+	TypeNumber ValueType = "number"
+	// TypeBool writes the values as booleans.
+	TypeBool ValueType = "bool"
+)
+
+// String returns the string representation of the value type.
+func (v ValueType) String() string {
+	if v == TypeString {
+		return "string"
+	}
+	return string(v)
+}
+
 // Column defines column properties.
 type Column struct {
 	Title    string    // Column header title
 	Width    int       // Column width (0 = auto-width)
 	MaxWidth int       // Maximum width for auto-width columns (0 = no limit)
+	MinWidth int       // Minimum width when shrinking to fit MaxTableWidth (0 = 3)
 	Align    Alignment // Alignment: Left, Center, Right
+	Overflow Overflow  // Overflow policy: OverflowTruncate, OverflowTruncateMiddle, OverflowTruncateStart, OverflowWrap, OverflowWordWrap
+	Style    Style     // Style of the data cells in the column
+	Type     ValueType // Type of the values in JSON and YAML output: TypeString, TypeNumber, TypeBool
+}
+
+// ColumnGroup defines a group header spanning adjacent columns.
+type ColumnGroup struct {
+	Title string    // Group header title (empty = no group)
+	Span  int       // Number of columns in the group (0 or 1 = single column)
+	Align Alignment // Alignment of the title (Default = Center)
 }
 
 // Cell represents a table cell.
 type Cell struct {
 	Content string    // Cell content
 	Align   Alignment // Cell-specific alignment override
+	Span    int       // Number of columns the cell spans (0 or 1 = single column)
+	RowSpan int       // Number of rows the cell spans in buffered mode (0 or 1 = single row)
+
+	VAlign VerticalAlignment // Vertical alignment within rows spanned by the cell
+	Style  Style             // Cell style, overriding the row and column styles
+	Link   string            // URL the content links to, written as an OSC 8 hyperlink
 }
 
 // Row represents a table row.
 type Row struct {
 	Cells []Cell // Row cells
+	Style Style  // Style of the row cells, overriding the column styles
+
+	separator bool // Draw a separator line before the row (see Table.AddSeparator)
 }
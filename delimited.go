@@ -0,0 +1,149 @@
+package termhyo
+
+import (
+	"encoding/csv"
+	"strings"
+)
+
+// delimited holds the state shared by the CSV and TSV renderers.
+// They write the header and each row as a record as soon as it is added,
+// so rows are never buffered.
+type delimited struct {
+	rendered   bool
+	headerDone bool
+}
+
+// addRow writes the header if it has not been written yet, followed by the row.
+func (d *delimited) addRow(table *Table, row Row, write func([]string) error) error {
+	if d.rendered {
+		return ErrAddAfterRender
+	}
+	if len(table.columns) == 0 {
+		return ErrNoColumns
+	}
+	if err := d.writeHeader(table, write); err != nil {
+		return err
+	}
+	return write(plainRecord(table, row))
+}
+
+// render writes the header if no rows have been added, followed by the footer rows.
+func (d *delimited) render(table *Table, write func([]string) error) error {
+	if d.rendered {
+		return ErrTableAlreadyRendered
+	}
+	if len(table.columns) == 0 {
+		return ErrNoColumns
+	}
+	if err := d.writeHeader(table, write); err != nil {
+		return err
+	}
+	// Footer rows are written as plain records after the data rows
+	for _, footer := range table.footers {
+		if err := write(plainRecord(table, footer)); err != nil {
+			return err
+		}
+	}
+	d.rendered = true
+	return nil
+}
+
+// writeHeader writes the column titles as the first record.
+// Column groups are flattened into the titles, since records cannot represent nested headers.
+func (d *delimited) writeHeader(table *Table, write func([]string) error) error {
+	if d.headerDone {
+		return nil
+	}
+	d.headerDone = true
+	record := make([]string, len(table.columns))
+	for i := range table.columns {
+		record[i] = plainText(table.headerTitle(i))
+	}
+	return write(record)
+}
+
+// IsRendered returns whether the table has been rendered.
+func (d *delimited) IsRendered() bool {
+	return d.rendered
+}
+
+// plainRecord returns the fields of a row without escape sequences.
+// A spanned cell is written in its first column, and the other columns it covers are left empty.
+func plainRecord(table *Table, row Row) []string {
+	record := make([]string, 0, len(table.columns))
+	for _, slot := range table.layoutRow(row) {
+		var field string
+		if !slot.empty && !slot.covered {
+			field = plainText(slot.cell.Content)
+		}
+		record = append(record, field)
+		for range slot.span - 1 {
+			record = append(record, "")
+		}
+	}
+	return record
+}
+
+// CSVRenderer writes the table as comma-separated values following RFC 4180.
+// Fields containing commas, double quotes or line breaks are quoted, and records end with CRLF.
+type CSVRenderer struct {
+	delimited
+	writer *csv.Writer
+}
+
+// AddRow writes a row as a CSV record.
+func (r *CSVRenderer) AddRow(table *Table, row Row) error {
+	return r.addRow(table, row, r.write(table))
+}
+
+// Render writes the footer rows, and the header if no rows have been added.
+func (r *CSVRenderer) Render(table *Table) error {
+	return r.render(table, r.write(table))
+}
+
+// write returns a function writing a record to the table writer.
+// Each record is flushed immediately.
+func (r *CSVRenderer) write(table *Table) func([]string) error {
+	if r.writer == nil {
+		r.writer = csv.NewWriter(table.writer)
+		r.writer.UseCRLF = true
+	}
+	return func(record []string) error {
+		if err := r.writer.Write(record); err != nil {
+			return err
+		}
+		r.writer.Flush()
+		return r.writer.Error()
+	}
+}
+
+// tsvEscaper escapes the characters that cannot appear in TSV fields.
+var tsvEscaper = strings.NewReplacer(`\`, `\\`, "\t", `\t`, "\n", `\n`, "\r", `\r`)
+
+// TSVRenderer writes the table as tab-separated values following the IANA text/tab-separated-values format.
+// Fields cannot contain tabs or line breaks, so they are escaped as \t, \n and \r, and backslashes as \\.
+type TSVRenderer struct {
+	delimited
+}
+
+// AddRow writes a row as a TSV record.
+func (r *TSVRenderer) AddRow(table *Table, row Row) error {
+	return r.addRow(table, row, r.write(table))
+}
+
+// Render writes the footer rows, and the header if no rows have been added.
+func (r *TSVRenderer) Render(table *Table) error {
+	return r.render(table, r.write(table))
+}
+
+// write returns a function writing a record to the table writer.
+func (r *TSVRenderer) write(table *Table) func([]string) error {
+	return func(record []string) error {
+		fields := make([]string, len(record))
+		for i, field := range record {
+			fields[i] = tsvEscaper.Replace(field)
+		}
+		_, err := table.writer.Write([]byte(strings.Join(fields, "\t") + "\n"))
+		return err
+	}
+}
@@ -27,6 +27,7 @@ func main() {
 		{"Minimal", termhyo.MinimalStyle, "No visible borders", "None"},
 		{"Markdown", termhyo.MarkdownStyle, "Markdown table format", "Middle only"},
 		{"TSV", termhyo.TSVStyle, "Tab-separated values", "Tabs only"},
+		{"CSV", termhyo.CSVStyle, "Comma-separated values", "Commas only"},
 	}
 
 	for _, config := range testConfigs {
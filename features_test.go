@@ -2,6 +2,11 @@ package termhyo
 
 import (
 	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"slices"
+	"strings"
 	"testing"
 )
 
@@ -53,6 +58,271 @@ func TestIndividualFeatures(t *testing.T) {
 		}
 	})
 
+	t.Run("MaxTableWidth", func(t *testing.T) {
+		columns := []Column{
+			{Title: "ID", Width: 0, Align: Right},
+			{Title: "Name", Width: 0, Align: Left, MinWidth: 6},
+			{Title: "Description", Width: 0, Align: Left},
+		}
+
+		for _, limit := range []int{60, 40, 30, 21} {
+			var buf bytes.Buffer
+			table := NewTable(&buf, slices.Clone(columns), MaxTableWidth(limit))
+			table.AddRow("1", "Alexander Hamilton", "A very long description of the first row")
+			table.Render()
+
+			for line := range strings.Lines(buf.String()) {
+				if width := StringWidth(strings.TrimSuffix(line, "\n")); width > limit {
+					t.Errorf("MaxTableWidth(%d): line width %d exceeds the limit: %q", limit, width, line)
+				}
+			}
+			// The ID column is narrower than the minimum width and is never shrunk
+			if table.columns[0].Width != 2 {
+				t.Errorf("MaxTableWidth(%d): ID column width = %d, expected 2", limit, table.columns[0].Width)
+			}
+			if table.columns[1].Width < 6 {
+				t.Errorf("MaxTableWidth(%d): Name column width = %d, expected at least 6", limit, table.columns[1].Width)
+			}
+		}
+	})
+
+	t.Run("ThemeRegistry", func(t *testing.T) {
+		if names := ThemeNames(); !slices.Equal(names, []string{"dark", "light", "plain", "solarized"}) {
+			t.Errorf("ThemeNames() = %v, expected the built-in themes", names)
+		}
+		if _, ok := GetTheme("unknown"); ok {
+			t.Error("GetTheme(\"unknown\") should not find a theme")
+		}
+
+		// Changing a returned theme does not change the registered one
+		theme, ok := GetTheme("plain")
+		if !ok {
+			t.Fatal("GetTheme(\"plain\") should find the built-in theme")
+		}
+		theme.Border.Chars["vertical"] = "!"
+		if plain, _ := GetTheme("plain"); plain.Border.Chars["vertical"] != "│" {
+			t.Errorf("registered theme was modified: vertical = %q", plain.Border.Chars["vertical"])
+		}
+
+		theme.Header = Style{Bold: true}
+		RegisterTheme("custom", theme)
+		defer func() {
+			themesMu.Lock()
+			delete(themes, "custom")
+			themesMu.Unlock()
+		}()
+		var buf bytes.Buffer
+		table := NewTable(&buf, []Column{{Title: "Test"}}, WithTheme(theme))
+		if custom, _ := GetTheme("custom"); !custom.Header.Bold || !table.GetHeaderStyle().Bold {
+			t.Error("registered theme should keep the header style")
+		}
+		if table.GetBorderConfig().Chars["vertical"] != "!" {
+			t.Error("WithTheme should set the border characters")
+		}
+	})
+
+	t.Run("ColorPolicy", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			policy   ColorPolicy
+			env      map[string]string
+			expected bool
+		}{
+			{"always", ColorAlways, map[string]string{"NO_COLOR": "1"}, true},
+			{"never", ColorNever, map[string]string{"CLICOLOR_FORCE": "1"}, false},
+			{"auto not a terminal", ColorAuto, nil, false},
+			{"auto forced", ColorAuto, map[string]string{"CLICOLOR_FORCE": "1"}, true},
+			{"auto forced with 0", ColorAuto, map[string]string{"CLICOLOR_FORCE": "0"}, false},
+			{"auto NO_COLOR wins", ColorAuto, map[string]string{"NO_COLOR": "1", "CLICOLOR_FORCE": "1"}, false},
+			{"auto dumb terminal", ColorAuto, map[string]string{"TERM": "dumb"}, false},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				for _, key := range []string{"NO_COLOR", "CLICOLOR_FORCE", "TERM"} {
+					t.Setenv(key, test.env[key])
+				}
+				var buf bytes.Buffer
+				if got := colorEnabled(test.policy, &buf); got != test.expected {
+					t.Errorf("colorEnabled(%s) = %v, expected %v", test.policy, got, test.expected)
+				}
+			})
+		}
+	})
+
+	t.Run("ColorNeverStripsStyles", func(t *testing.T) {
+		for _, style := range []BorderStyle{BoxDrawingStyle, MarkdownStyle} {
+			var buf bytes.Buffer
+			columns := []Column{
+				{Title: "Name", Style: Style{ForegroundColor: AnsiCyan}},
+				{Title: "\x1b[4mStatus\x1b[0m"},
+			}
+			table := NewTable(&buf, columns,
+				Border(style),
+				Header(BoldHeaderStyle()),
+				BorderColor(Style{Dim: true}),
+				Stripes(Style{}, Style{BackgroundColor: AnsiBgBlue}),
+				Rules(Rule{Condition: ColumnEquals("Status", "FAIL"), Style: Style{ForegroundColor: AnsiRed}}),
+				Caption(AnsiItalic+"caption"+AnsiReset),
+				ColorOutput(ColorNever),
+			)
+			table.AddRow("build", AnsiRed+"FAIL"+AnsiReset)
+			table.AddRow("test", "PASS")
+			table.Render()
+
+			if strings.Contains(buf.String(), "\x1b") {
+				t.Errorf("%s: output contains escape sequences with ColorNever:\n%q", style, buf.String())
+			}
+		}
+	})
+
+	t.Run("ColorProfileConversion", func(t *testing.T) {
+		tests := []struct {
+			input    string
+			profile  ColorProfile
+			expected string
+		}{
+			{TrueColorFg(255, 0, 0), ProfileTrueColor, "\x1b[38;2;255;0;0m"},
+			{TrueColorFg(255, 0, 0), ProfileANSI256, "\x1b[38;5;196m"},
+			{TrueColorFg(255, 0, 0), ProfileANSI16, AnsiBrightRed},
+			{TrueColorFg(255, 0, 0), ProfileNoColor, ""},
+			{RGB256(21), ProfileANSI256, RGB256(21)},
+			{RGB256(21), ProfileANSI16, AnsiBlue},
+			{BgRGB256(236), ProfileANSI16, AnsiBgBlack},
+			{BgRGB256(3), ProfileANSI16, AnsiBgYellow},
+			{AnsiRed, ProfileANSI16, AnsiRed},
+			{AnsiRed, ProfileNoColor, ""},
+			{"\x1b[1;38;2;0;0;0m", ProfileNoColor, AnsiBold},
+			{"a" + TrueColorBg(0, 95, 135) + "b" + AnsiReset, ProfileANSI256, "a\x1b[48;5;24mb" + AnsiReset},
+		}
+
+		for _, test := range tests {
+			if result := convertSGR(test.input, test.profile); result != test.expected {
+				t.Errorf("convertSGR(%q, %s) = %q, expected %q", test.input, test.profile, result, test.expected)
+			}
+		}
+	})
+
+	t.Run("DetectColorProfile", func(t *testing.T) {
+		tests := []struct {
+			colorterm, term string
+			expected        ColorProfile
+		}{
+			{"truecolor", "xterm-256color", ProfileTrueColor},
+			{"", "xterm-256color", ProfileANSI256},
+			{"", "xterm-direct", ProfileTrueColor},
+			{"", "xterm", ProfileANSI16},
+			{"", "linux", ProfileANSI16},
+			{"", "dumb", ProfileNoColor},
+		}
+
+		for _, test := range tests {
+			t.Setenv("COLORTERM", test.colorterm)
+			t.Setenv("TERM", test.term)
+			t.Setenv("WT_SESSION", "")
+			if result := DetectColorProfile(); result != test.expected {
+				t.Errorf("DetectColorProfile() with COLORTERM=%q TERM=%q = %s, expected %s", test.colorterm, test.term, result, test.expected)
+			}
+		}
+	})
+
+	t.Run("ColorProfileOutput", func(t *testing.T) {
+		var buf bytes.Buffer
+		theme, _ := GetTheme("solarized")
+		table := NewTable(&buf, []Column{{Title: "Name"}}, WithTheme(theme), WithColorProfile(ProfileANSI16))
+		table.AddRow(TrueColorFg(255, 0, 0) + "Alice" + AnsiReset)
+		table.Render()
+
+		if strings.Contains(buf.String(), "38;2;") || strings.Contains(buf.String(), "48;2;") {
+			t.Errorf("output contains 24-bit colors with ProfileANSI16:\n%q", buf.String())
+		}
+		if !strings.Contains(buf.String(), AnsiBrightRed+"Alice") {
+			t.Errorf("content colors should be converted:\n%q", buf.String())
+		}
+	})
+
+	t.Run("Color", func(t *testing.T) {
+		tests := []struct {
+			input    string
+			expected Color
+			name     string
+			fg, bg   string
+		}{
+			{"", ColorDefault, "default", "", ""},
+			{"red", ColorRed, "red", AnsiRed, AnsiBgRed},
+			{"Bright-Blue", ColorBrightBlue, "bright_blue", AnsiBrightBlue, AnsiBgBrightBlue},
+			{"grey", ColorBrightBlack, "bright_black", AnsiBrightBlack, AnsiBgBrightBlack},
+			{"208", PaletteColor(208), "208", RGB256(208), BgRGB256(208)},
+			{"#268BD2", RGBColor(38, 139, 210), "#268bd2", TrueColorFg(38, 139, 210), TrueColorBg(38, 139, 210)},
+			{AnsiMagenta, ColorMagenta, "magenta", AnsiMagenta, AnsiBgMagenta},
+			{AnsiBgBrightCyan, ColorBrightCyan, "bright_cyan", AnsiBrightCyan, AnsiBgBrightCyan},
+			{BgRGB256(17), PaletteColor(17), "17", RGB256(17), BgRGB256(17)},
+			{TrueColorFg(1, 2, 3), RGBColor(1, 2, 3), "#010203", TrueColorFg(1, 2, 3), TrueColorBg(1, 2, 3)},
+		}
+
+		for _, test := range tests {
+			c, err := ParseColor(test.input)
+			if err != nil {
+				t.Errorf("ParseColor(%q) error: %v", test.input, err)
+				continue
+			}
+			if c != test.expected {
+				t.Errorf("ParseColor(%q) = %v, expected %v", test.input, c, test.expected)
+			}
+			if c.String() != test.name || c.Fg() != test.fg || c.Bg() != test.bg {
+				t.Errorf("ParseColor(%q) = %s %q %q, expected %s %q %q", test.input, c, c.Fg(), c.Bg(), test.name, test.fg, test.bg)
+			}
+		}
+
+		for _, input := range []string{"purple", "256", "#12345", AnsiBold} {
+			if _, err := ParseColor(input); err == nil {
+				t.Errorf("ParseColor(%q) should fail", input)
+			}
+		}
+
+		// Typed colors and escape sequences render the same, and typed colors take precedence
+		typed := Style{Bold: true, Foreground: ColorRed, Background: PaletteColor(236)}
+		legacy := Style{Bold: true, ForegroundColor: AnsiRed, BackgroundColor: BgRGB256(236)}
+		if typed.ApplyStyle("x") != legacy.ApplyStyle("x") {
+			t.Errorf("typed style %q differs from legacy style %q", typed.ApplyStyle("x"), legacy.ApplyStyle("x"))
+		}
+		if legacy.foreground() != ColorRed || legacy.background() != PaletteColor(236) {
+			t.Errorf("legacy style colors = %v, %v", legacy.foreground(), legacy.background())
+		}
+		combined := legacy.Combine(Style{Foreground: ColorGreen})
+		if combined.ApplyStyle("x") != "\x1b[1m\x1b[32m\x1b[48;5;236mx\x1b[0m" {
+			t.Errorf("Combine() = %q", combined.ApplyStyle("x"))
+		}
+	})
+
+	t.Run("StyleAfterResets", func(t *testing.T) {
+		prefix := AnsiBold
+		tests := []struct {
+			input    string
+			expected string
+		}{
+			{"a\x1b[0mb", "a\x1b[0m" + prefix + "b"},
+			{"a\x1b[mb", "a\x1b[m" + prefix + "b"},
+			{"a\x1b[0;1mb", "a\x1b[0;1m" + prefix + "b"},
+			{"a\x1b[1;00mb", "a\x1b[1;00m" + prefix + "b"},
+			{"a\x1b[38;5;0mb", "a\x1b[38;5;0mb"},
+			{"a\x1b[48;2;0;0;0mb", "a\x1b[48;2;0;0;0mb"},
+		}
+		for _, test := range tests {
+			if result := reopenStyle(test.input, prefix); result != test.expected {
+				t.Errorf("reopenStyle(%q) = %q, expected %q", test.input, result, test.expected)
+			}
+		}
+
+		var buf bytes.Buffer
+		table := NewTable(&buf, []Column{{Title: "A"}}, ColorOutput(ColorAlways), Border(MinimalStyle))
+		table.AddRowCells(Cell{Content: "a\x1b[31mb\x1b[mc", Style: Style{Bold: true}})
+		table.Render()
+		if !strings.Contains(buf.String(), "\x1b[m"+AnsiBold+"c") {
+			t.Errorf("cell style is not re-opened after \\x1b[m: %q", buf.String())
+		}
+	})
+
 	t.Run("BorderConfigDisabling", func(t *testing.T) {
 		var buf bytes.Buffer
 		columns := []Column{
@@ -185,6 +455,222 @@ func TestRenderModes(t *testing.T) {
 		}
 	})
 
+	t.Run("MarkdownLinkEscaping", func(t *testing.T) {
+		var buf bytes.Buffer
+		table := NewTable(&buf, []Column{{Title: "A"}}, Border(MarkdownStyle))
+		table.AddRowCells(Cell{Content: "see [1]", Link: "https://example.com/a b_(c)"})
+		table.Render()
+		if expected := `[see \[1\]](https://example.com/a%20b_%28c%29)`; !strings.Contains(buf.String(), expected) {
+			t.Errorf("Markdown link = %q, expected it to contain %q", buf.String(), expected)
+		}
+	})
+
+	t.Run("SetBorderStyleRenderer", func(t *testing.T) {
+		var buf bytes.Buffer
+		table := NewTable(&buf, []Column{{Title: "A"}, {Title: "B"}})
+		table.SetBorderStyle(CSVStyle)
+		table.AddRow(`x,"1"`, "y")
+		table.Render()
+		if expected := "A,B\r\n\"x,\"\"1\"\"\",y\r\n"; buf.String() != expected {
+			t.Errorf("SetBorderStyle(CSVStyle) output = %q, expected %q", buf.String(), expected)
+		}
+	})
+
+	t.Run("DelimitedStreaming", func(t *testing.T) {
+		var buf bytes.Buffer
+		columns := []Column{
+			{Title: "Name", Width: 0, Align: Left}, // Auto width does not buffer delimited output
+			{Title: "Note", Width: 0, Align: Left},
+		}
+
+		table := NewTable(&buf, columns, Border(CSVStyle))
+		table.AddRow("Alice", "says \"hi\"")
+		if expected := "Name,Note\r\nAlice,\"says \"\"hi\"\"\"\r\n"; buf.String() != expected {
+			t.Errorf("CSV rows should be written as they are added: got %q, expected %q", buf.String(), expected)
+		}
+
+		buf.Reset()
+		table = NewTable(&buf, columns, Border(TSVStyle))
+		table.AddRow("Bob", "a\tb\\c")
+		if expected := "Name\tNote\nBob\ta\\tb\\\\c\n"; buf.String() != expected {
+			t.Errorf("TSV rows should be written as they are added: got %q, expected %q", buf.String(), expected)
+		}
+	})
+
+	t.Run("JSONOutput", func(t *testing.T) {
+		var buf bytes.Buffer
+		columns := []Column{
+			{Title: "Name", Width: 0, Align: Left},
+			{Title: "Count", Width: 0, Align: Right, Type: TypeNumber},
+		}
+
+		table := NewTable(&buf, columns, Border(JSONLinesStyle))
+		table.AddRow("a\tb", "1")
+		if expected := `{"Name": "a\tb", "Count": 1}` + "\n"; buf.String() != expected {
+			t.Errorf("JSON Lines rows should be written as they are added: got %q, expected %q", buf.String(), expected)
+		}
+
+		buf.Reset()
+		table = NewTable(&buf, columns, Border(JSONStyle))
+		table.AddRow("x", "2")
+		table.AddRow("y", "many")
+		table.Render()
+		var objects []map[string]any
+		if err := json.Unmarshal(buf.Bytes(), &objects); err != nil {
+			t.Fatalf("JSON output is not valid: %v\n%s", err, buf.String())
+		}
+		if len(objects) != 2 || objects[0]["Count"] != 2.0 || objects[1]["Count"] != "many" {
+			t.Errorf("unexpected JSON objects: %v", objects)
+		}
+
+		// Duplicate titles get the column number appended so that no key is lost
+		buf.Reset()
+		table = NewTable(&buf, []Column{{Title: "Name"}, {Title: "Name"}, {Title: "Name_2"}}, Border(JSONLinesStyle))
+		table.AddRow("a", "b", "c")
+		if expected := `{"Name": "a", "Name_2": "b", "Name_2_3": "c"}` + "\n"; buf.String() != expected {
+			t.Errorf("duplicate titles: got %q, expected %q", buf.String(), expected)
+		}
+	})
+
+	t.Run("YAMLOutput", func(t *testing.T) {
+		var buf bytes.Buffer
+		columns := []Column{
+			{Title: "Name", Width: 0, Align: Left},
+			{Title: "Count", Width: 0, Align: Right, Type: TypeNumber},
+		}
+
+		table := NewTable(&buf, columns, Border(YAMLStyle))
+		values := []string{"true", "~", "1.5", "on", "[x]", "a # b", "-", "it's", "=", "<<", "\n", "\n\n", "e\u2028", "a\u0085b", "x\u2029"}
+		for _, v := range values {
+			table.AddRow(v, "7")
+		}
+		table.Render()
+
+		// Strings that look like other types are read back as strings
+		value, err := parseYAML(buf.Bytes())
+		if err != nil {
+			t.Fatalf("YAML output is not valid: %v\n%s", err, buf.String())
+		}
+		items, ok := value.([]any)
+		if !ok || len(items) != len(values) {
+			t.Fatalf("expected a sequence of %d items, got %v", len(values), value)
+		}
+		for i, item := range items {
+			mapping, _ := item.(map[string]any)
+			if mapping["Name"] != values[i] || mapping["Count"] != json.Number("7") {
+				t.Errorf("item %d = %v, expected Name %q and Count 7", i, item, values[i])
+			}
+		}
+
+		// Floats are written in a form YAML 1.1 loaders also read as numbers
+		for input, expected := range map[string]string{"12": "12", "-0.5": "-0.5", "1e3": "1.0e+3", "3.5E-2": "3.5e-2"} {
+			if result := yamlNumber(input); result != expected {
+				t.Errorf("yamlNumber(%q) = %q, expected %q", input, result, expected)
+			}
+		}
+
+		// Duplicate titles do not produce duplicate keys, which YAML parsers reject
+		buf.Reset()
+		table = NewTable(&buf, []Column{{Title: "Name"}, {Title: "Name"}}, Border(YAMLStyle))
+		table.AddRow("a", "b")
+		table.Render()
+		value, err = parseYAML(buf.Bytes())
+		if err != nil {
+			t.Fatalf("YAML output with duplicate titles is not valid: %v\n%s", err, buf.String())
+		}
+		if items, _ := value.([]any); len(items) != 1 || !reflect.DeepEqual(items[0], map[string]any{"Name": "a", "Name_2": "b"}) {
+			t.Errorf("duplicate titles: got %v", value)
+		}
+	})
+
+	t.Run("LaTeXMultiLine", func(t *testing.T) {
+		tests := []struct {
+			input    string
+			expected string
+		}{
+			{"a_b", `a\_b`},
+			{"a\n", "a"},
+			{"a\nb\n\n", `\begin{tabular}[t]{@{}l@{}}a \\ b\end{tabular}`},
+		}
+		for _, test := range tests {
+			if result := latexContent(test.input, "l"); result != test.expected {
+				t.Errorf("latexContent(%q) = %q, expected %q", test.input, result, test.expected)
+			}
+		}
+	})
+
+	t.Run("HTMLLinks", func(t *testing.T) {
+		tests := []struct {
+			link string
+			safe bool
+		}{
+			{"https://example.com/a?b=c", true},
+			{"HTTP://example.com", true},
+			{"mailto:someone@example.com", true},
+			{"../docs/index.html", true},
+			{"#top", true},
+			{"javascript:alert(1)", false},
+			{"JavaScript:alert(1)", false},
+			{" javascript:alert(1)", false},
+			{"java\tscript:alert(1)", false},
+			{"data:text/html,<script>", false},
+		}
+		for _, test := range tests {
+			var buf bytes.Buffer
+			table := NewTable(&buf, []Column{{Title: "A"}}, Border(HTMLStyle))
+			table.AddRowCells(Cell{Content: "x", Link: test.link})
+			table.Render()
+			if linked := strings.Contains(buf.String(), "<a href="); linked != test.safe {
+				t.Errorf("link %q written as a link = %v, expected %v:\n%s", test.link, linked, test.safe, buf.String())
+			}
+			if !strings.Contains(buf.String(), ">x<") {
+				t.Errorf("link %q: content should be kept:\n%s", test.link, buf.String())
+			}
+		}
+	})
+
+	t.Run("HTMLColorNever", func(t *testing.T) {
+		var buf bytes.Buffer
+		columns := []Column{
+			{Title: "Name", Width: 0, Align: Left, Style: Style{Foreground: ColorBlue}},
+		}
+
+		table := NewTable(&buf, columns, Border(HTMLStyle), Header(Style{Bold: true}), ColorOutput(ColorNever))
+		table.AddRow("\x1b[31mred\x1b[0m")
+		table.Render()
+
+		output := buf.String()
+		if strings.Contains(output, "<span") || strings.Contains(output, "font-weight") || strings.Contains(output, "color:") {
+			t.Errorf("ColorNever should write HTML without styles:\n%s", output)
+		}
+		if !strings.Contains(output, `<td style="text-align: left">red</td>`) {
+			t.Errorf("ColorNever should keep the content and alignment:\n%s", output)
+		}
+
+		// Other policies write the styles with their own colors, even to a buffer
+		buf.Reset()
+		table = NewTable(&buf, columns, Border(HTMLStyle), Header(Style{Bold: true}), WithColorProfile(ProfileANSI16))
+		table.AddRow(TrueColorFg(1, 2, 3) + "rgb" + AnsiReset)
+		table.Render()
+		output = buf.String()
+		if !strings.Contains(output, "font-weight: bold") || !strings.Contains(output, `<span style="color: #010203">rgb</span>`) {
+			t.Errorf("HTML styles should not depend on the terminal or color profile:\n%s", output)
+		}
+	})
+
+	t.Run("DataFormatsWithoutColumns", func(t *testing.T) {
+		for _, style := range []BorderStyle{CSVStyle, TSVStyle, HTMLStyle, JSONStyle, JSONLinesStyle, YAMLStyle, LaTeXStyle} {
+			var buf bytes.Buffer
+			table := NewTable(&buf, nil, Border(style))
+			if err := table.Render(); !errors.Is(err, ErrNoColumns) {
+				t.Errorf("%s: Render() error = %v, expected ErrNoColumns", style, err)
+			}
+			if buf.Len() > 0 {
+				t.Errorf("%s: a table without columns should not write output, got %q", style, buf.String())
+			}
+		}
+	})
+
 	t.Run("NoAlignMode", func(t *testing.T) {
 		var buf bytes.Buffer
 		columns := []Column{
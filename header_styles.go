@@ -58,8 +58,9 @@ const (
 	AnsiBgBrightWhite   = "\x1b[107m"
 )
 
-// HeaderStyle defines the styling for table headers.
-type HeaderStyle struct {
+// Style defines the text formatting and colors of table content.
+// It is used for the header, footer, columns, rows and cells.
+type Style struct {
 	// Text formatting
 	Bold      bool
 	Underline bool
@@ -70,6 +71,10 @@ type HeaderStyle struct {
 	Strike    bool
 
 	// Colors
+	Foreground Color // Text color (ColorDefault = use ForegroundColor)
+	Background Color // Background color (ColorDefault = use BackgroundColor)
+
+	// Colors as escape sequences, such as AnsiRed or the result of RGB256
 	ForegroundColor string // ANSI color code or empty for default
 	BackgroundColor string // ANSI color code or empty for default
 
@@ -78,6 +83,10 @@ type HeaderStyle struct {
 	CustomSuffix string // Custom ANSI sequence to append
 }
 
+// HeaderStyle defines the styling for table headers.
+// It is the same type as Style.
+type HeaderStyle = Style
+
 // DefaultHeaderStyle returns a default header style with bold and underline.
 func DefaultHeaderStyle() HeaderStyle {
 	return HeaderStyle{
@@ -108,137 +117,95 @@ func ColoredHeaderStyle(fgColor, bgColor string) HeaderStyle {
 	}
 }
 
-// ApplyStyle applies the header style to the given text.
-func (hs HeaderStyle) ApplyStyle(text string) string {
-	if hs.isEmpty() {
+// ApplyStyle applies the style to the given text.
+func (s Style) ApplyStyle(text string) string {
+	if s.isEmpty() {
 		return text
 	}
-
-	var prefix string
-	suffix := AnsiReset
-
-	// Add custom prefix if specified
-	if hs.CustomPrefix != "" {
-		prefix += hs.CustomPrefix
-	}
-
-	// Add text formatting
-	if hs.Bold {
-		prefix += AnsiBold
-	}
-	if hs.Dim {
-		prefix += AnsiDim
-	}
-	if hs.Italic {
-		prefix += AnsiItalic
-	}
-	if hs.Underline {
-		prefix += AnsiUnderline
-	}
-	if hs.Blink {
-		prefix += AnsiBlink
-	}
-	if hs.Reverse {
-		prefix += AnsiReverse
-	}
-	if hs.Strike {
-		prefix += AnsiStrike
-	}
-
-	// Add foreground color
-	if hs.ForegroundColor != "" {
-		prefix += hs.ForegroundColor
-	}
-
-	// Add background color
-	if hs.BackgroundColor != "" {
-		prefix += hs.BackgroundColor
-	}
-
-	// Add custom suffix if specified
-	if hs.CustomSuffix != "" {
-		suffix = hs.CustomSuffix + suffix
-	}
-
-	return prefix + text + suffix
+	return s.getPrefix() + text + s.getSuffix()
 }
 
-// getPrefix returns the ANSI prefix for the header style.
-func (hs HeaderStyle) getPrefix() string {
-	if hs.isEmpty() {
+// getPrefix returns the ANSI prefix for the style.
+func (s Style) getPrefix() string {
+	if s.isEmpty() {
 		return ""
 	}
 
 	var prefix string
 
 	// Add custom prefix if specified
-	if hs.CustomPrefix != "" {
-		prefix += hs.CustomPrefix
+	if s.CustomPrefix != "" {
+		prefix += s.CustomPrefix
 	}
 
 	// Add text formatting
-	if hs.Bold {
+	if s.Bold {
 		prefix += AnsiBold
 	}
-	if hs.Dim {
+	if s.Dim {
 		prefix += AnsiDim
 	}
-	if hs.Italic {
+	if s.Italic {
 		prefix += AnsiItalic
 	}
-	if hs.Underline {
+	if s.Underline {
 		prefix += AnsiUnderline
 	}
-	if hs.Blink {
+	if s.Blink {
 		prefix += AnsiBlink
 	}
-	if hs.Reverse {
+	if s.Reverse {
 		prefix += AnsiReverse
 	}
-	if hs.Strike {
+	if s.Strike {
 		prefix += AnsiStrike
 	}
 
 	// Add foreground color
-	if hs.ForegroundColor != "" {
-		prefix += hs.ForegroundColor
+	if s.Foreground != ColorDefault {
+		prefix += s.Foreground.Fg()
+	} else if s.ForegroundColor != "" {
+		prefix += s.ForegroundColor
 	}
 
 	// Add background color
-	if hs.BackgroundColor != "" {
-		prefix += hs.BackgroundColor
+	if s.Background != ColorDefault {
+		prefix += s.Background.Bg()
+	} else if s.BackgroundColor != "" {
+		prefix += s.BackgroundColor
 	}
 
 	return prefix
 }
 
-// getSuffix returns the ANSI suffix for the header style.
-func (hs HeaderStyle) getSuffix() string {
-	if hs.isEmpty() {
+// getSuffix returns the ANSI suffix for the style.
+func (s Style) getSuffix() string {
+	if s.isEmpty() {
 		return ""
 	}
 
 	suffix := AnsiReset
 
 	// Add custom suffix if specified
-	if hs.CustomSuffix != "" {
-		suffix = hs.CustomSuffix + suffix
+	if s.CustomSuffix != "" {
+		suffix = s.CustomSuffix + suffix
 	}
 
 	return suffix
 }
 
-// isEmpty checks if the header style has any formatting applied.
-func (hs HeaderStyle) isEmpty() bool {
-	return !hs.Bold && !hs.Underline && !hs.Italic && !hs.Dim &&
-		!hs.Blink && !hs.Reverse && !hs.Strike &&
-		hs.ForegroundColor == "" && hs.BackgroundColor == "" &&
-		hs.CustomPrefix == "" && hs.CustomSuffix == ""
+// isEmpty checks if the style has any formatting applied.
+func (s Style) isEmpty() bool {
+	return !s.Bold && !s.Underline && !s.Italic && !s.Dim &&
+		!s.Blink && !s.Reverse && !s.Strike &&
+		s.Foreground == ColorDefault && s.Background == ColorDefault &&
+		s.ForegroundColor == "" && s.BackgroundColor == "" &&
+		s.CustomPrefix == "" && s.CustomSuffix == ""
 }
 
-// Combine combines this header style with another, with the other style taking precedence.
-func (hs HeaderStyle) Combine(other HeaderStyle) HeaderStyle {
-	result := hs
+// Combine combines this style with another, with the other style taking precedence.
+func (s Style) Combine(other Style) Style {
+	result := s
 
 	// Other style takes precedence for boolean values if set
 	if other.Bold {
@@ -263,12 +230,12 @@ func (hs HeaderStyle) Combine(other HeaderStyle) HeaderStyle {
 		result.Strike = true
 	}
 
-	// Other style takes precedence for colors if set
-	if other.ForegroundColor != "" {
-		result.ForegroundColor = other.ForegroundColor
+	// Other style takes precedence for colors if set, whether typed or escape sequences
+	if other.Foreground != ColorDefault || other.ForegroundColor != "" {
+		result.Foreground, result.ForegroundColor = other.Foreground, other.ForegroundColor
 	}
-	if other.BackgroundColor != "" {
-		result.BackgroundColor = other.BackgroundColor
+	if other.Background != ColorDefault || other.BackgroundColor != "" {
+		result.Background, result.BackgroundColor = other.Background, other.BackgroundColor
 	}
 
 	// Other style takes precedence for custom sequences if set
@@ -282,6 +249,24 @@ func (hs HeaderStyle) Combine(other HeaderStyle) HeaderStyle {
 	return result
 }
 
+// foreground returns the text color of the style, parsing ForegroundColor if Foreground is not set.
+func (s Style) foreground() Color {
+	if s.Foreground != ColorDefault {
+		return s.Foreground
+	}
+	c, _ := ParseColor(s.ForegroundColor)
+	return c
+}
+
+// background returns the background color of the style, parsing BackgroundColor if Background is not set.
+func (s Style) background() Color {
+	if s.Background != ColorDefault {
+		return s.Background
+	}
+	c, _ := ParseColor(s.BackgroundColor)
+	return c
+}
+
 // RGB256 returns a 256-color ANSI code for foreground.
 func RGB256(colorCode int) string {
 	return "\x1b[38;5;" + strconv.Itoa(colorCode) + "m"
@@ -0,0 +1,316 @@
+package termhyo
+
+import (
+	"fmt"
+	"html"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// HTMLRenderer writes the table as an HTML <table> element with <thead>, <tbody> and <tfoot> sections.
+// Column alignments become CSS text-align, spanned cells become colspan and rowspan,
+// and styles, including the SGR escape sequences in the content, become inline CSS.
+// Inline CSS does not depend on the terminal, so the styles are written with their own colors
+// unless the color policy is ColorNever, which writes plain HTML.
+type HTMLRenderer struct {
+	rendered bool
+	rows     []Row // Rows are buffered so that cells can span several rows
+}
+
+// AddRow adds a row for HTML rendering.
+func (r *HTMLRenderer) AddRow(_ *Table, row Row) error {
+	if r.rendered {
+		return ErrAddAfterRender
+	}
+	r.rows = append(r.rows, row)
+	return nil
+}
+
+// Render writes the table.
+func (r *HTMLRenderer) Render(table *Table) error {
+	if r.rendered {
+		return ErrTableAlreadyRendered
+	}
+	if len(table.columns) == 0 {
+		return ErrNoColumns
+	}
+
+	var b strings.Builder
+	b.WriteString("<table>\n")
+	if table.title != "" {
+		b.WriteString("<caption" + htmlStyleAttr(alignCSS(table.titleAlign)) + ">" + htmlText(table, table.title) + "</caption>\n")
+	}
+
+	b.WriteString("<thead>\n")
+	writeHTMLRows(&b, table, table.headerRows(), "th", rowStyle{line: table.headerStyle})
+	b.WriteString("</thead>\n")
+
+	b.WriteString("<tbody>\n")
+	writeHTMLRows(&b, table, r.rows, "td", rowStyle{stripes: table.stripes, columns: true})
+	b.WriteString("</tbody>\n")
+
+	if len(table.footers) > 0 {
+		b.WriteString("<tfoot>\n")
+		writeHTMLRows(&b, table, table.footers, "td", rowStyle{line: table.footerStyle, columns: true})
+		b.WriteString("</tfoot>\n")
+	}
+	b.WriteString("</table>\n")
+
+	// The caption follows the table, since a table has a single <caption> element
+	if table.caption != "" {
+		b.WriteString("<p" + htmlStyleAttr(alignCSS(table.captionAlign)) + ">" + htmlText(table, table.caption) + "</p>\n")
+	}
+
+	if _, err := table.writer.Write([]byte(b.String())); err != nil {
+		return err
+	}
+	r.rendered = true
+	return nil
+}
+
+// IsRendered returns whether the table has been rendered.
+func (r *HTMLRenderer) IsRendered() bool {
+	return r.rendered
+}
+
+// writeHTMLRows writes rows as <tr> elements of tag cells.
+// The line style of each row is set on the <tr> element, and the cell styles on the cells.
+func writeHTMLRows(b *strings.Builder, table *Table, rows []Row, tag string, style rowStyle) {
+	for r, slots := range table.layoutRows(rows) {
+		b.WriteString("<tr" + htmlStyleAttr(cssStyle(htmlStyle(table, style.lineStyle(r)))) + ">")
+		for _, slot := range slots {
+			if slot.covered {
+				continue
+			}
+
+			align := table.columns[slot.col].Align
+			if slot.cell.Align != Default {
+				align = slot.cell.Align
+			}
+			cellStyle := slot.style
+			if style.columns {
+				cellStyle = table.cellStyle(slot)
+			}
+			declarations := []string{alignCSS(align), cssStyle(htmlStyle(table, cellStyle))}
+			if slot.rowSpan > 1 && slot.cell.VAlign != AlignTop {
+				declarations = append(declarations, "vertical-align: "+slot.cell.VAlign.String())
+			}
+
+			b.WriteString("<" + tag)
+			if slot.span > 1 {
+				b.WriteString(` colspan="` + strconv.Itoa(slot.span) + `"`)
+			}
+			if slot.rowSpan > 1 {
+				b.WriteString(` rowspan="` + strconv.Itoa(slot.rowSpan) + `"`)
+			}
+			b.WriteString(htmlStyleAttr(declarations...) + ">")
+			if !slot.empty {
+				content := htmlText(table, slot.cell.Content)
+				if isSafeLink(slot.cell.Link) {
+					content = `<a href="` + html.EscapeString(slot.cell.Link) + `">` + content + "</a>"
+				}
+				b.WriteString(content)
+			}
+			b.WriteString("</" + tag + ">")
+		}
+		b.WriteString("</tr>\n")
+	}
+}
+
+// isSafeLink reports whether a link can be written as an href:
+// relative URLs and http, https and mailto URLs. Other links, such as javascript: URLs,
+// are written as plain text, since the HTML may be published as a web page.
+func isSafeLink(link string) bool {
+	if link == "" || strings.TrimLeft(link, "\x00\t\n\f\r ") != link {
+		return false
+	}
+	u, err := url.Parse(link)
+	if err != nil {
+		return false
+	}
+	switch strings.ToLower(u.Scheme) {
+	case "", "http", "https", "mailto":
+		return true
+	}
+	return false
+}
+
+// htmlStyle returns the style written as inline CSS, which is empty only for ColorNever.
+func htmlStyle(table *Table, style Style) Style {
+	if table.colorPolicy == ColorNever {
+		return Style{}
+	}
+	return style
+}
+
+// htmlText returns the HTML of content, without its styles for ColorNever.
+func htmlText(table *Table, content string) string {
+	if table.colorPolicy == ColorNever {
+		content = sgrRegex.ReplaceAllString(content, "")
+	}
+	return htmlContent(content)
+}
+
+// htmlContent returns the HTML of cell content.
+// Text is escaped, line breaks become <br>, and text styled by SGR escape sequences is wrapped in
+// <span> elements with the equivalent inline CSS. Other escape sequences are removed.
+func htmlContent(s string) string {
+	var b strings.Builder
+	var style Style
+	writeText := func(text string) {
+		if text == "" {
+			return
+		}
+		text = strings.ReplaceAll(html.EscapeString(text), "\n", "<br>")
+		if css := cssStyle(style); css != "" {
+			text = `<span style="` + css + `">` + text + "</span>"
+		}
+		b.WriteString(text)
+	}
+
+	pos := 0
+	for _, loc := range ansiEscapeRegex.FindAllStringIndex(s, -1) {
+		writeText(s[pos:loc[0]])
+		if params, ok := sgrParams(s[loc[0]:loc[1]]); ok {
+			style = applySGR(style, params)
+		}
+		pos = loc[1]
+	}
+	writeText(s[pos:])
+	return b.String()
+}
+
+// applySGR returns the style changed by the parameters of an SGR escape sequence.
+func applySGR(style Style, params []string) Style {
+	for i := 0; i < len(params); {
+		if c, background, next, ok := parseSGRColor(params, i); ok {
+			if background {
+				style.Background, style.BackgroundColor = c, ""
+			} else {
+				style.Foreground, style.ForegroundColor = c, ""
+			}
+			i = next
+			continue
+		}
+
+		switch params[i] {
+		case "", "0":
+			style = Style{}
+		case "1":
+			style.Bold = true
+		case "2":
+			style.Dim = true
+		case "3":
+			style.Italic = true
+		case "4":
+			style.Underline = true
+		case "5":
+			style.Blink = true
+		case "7":
+			style.Reverse = true
+		case "9":
+			style.Strike = true
+		case "22":
+			style.Bold, style.Dim = false, false
+		case "23":
+			style.Italic = false
+		case "24":
+			style.Underline = false
+		case "25":
+			style.Blink = false
+		case "27":
+			style.Reverse = false
+		case "29":
+			style.Strike = false
+		case "39":
+			style.Foreground, style.ForegroundColor = ColorDefault, ""
+		case "49":
+			style.Background, style.BackgroundColor = ColorDefault, ""
+		}
+		i++
+	}
+	return style
+}
+
+// cssStyle returns the inline CSS declarations of a style, or an empty string for an empty style.
+// SGR escape sequences in CustomPrefix are applied before the other attributes.
+func cssStyle(style Style) string {
+	if style.isEmpty() {
+		return ""
+	}
+	if style.CustomPrefix != "" {
+		base := Style{}
+		for _, seq := range sgrRegex.FindAllString(style.CustomPrefix, -1) {
+			params, _ := sgrParams(seq)
+			base = applySGR(base, params)
+		}
+		style.CustomPrefix, style.CustomSuffix = "", ""
+		style = base.Combine(style)
+	}
+
+	fg, bg := style.foreground(), style.background()
+	if style.Reverse {
+		fg, bg = bg, fg
+	}
+
+	var declarations []string
+	if fg != ColorDefault {
+		declarations = append(declarations, "color: "+cssColor(fg))
+	}
+	if bg != ColorDefault {
+		declarations = append(declarations, "background-color: "+cssColor(bg))
+	}
+	if style.Bold {
+		declarations = append(declarations, "font-weight: bold")
+	}
+	if style.Dim {
+		declarations = append(declarations, "opacity: 0.5")
+	}
+	if style.Italic {
+		declarations = append(declarations, "font-style: italic")
+	}
+
+	var decorations []string
+	if style.Underline {
+		decorations = append(decorations, "underline")
+	}
+	if style.Strike {
+		decorations = append(decorations, "line-through")
+	}
+	if style.Blink {
+		decorations = append(decorations, "blink")
+	}
+	if len(decorations) > 0 {
+		declarations = append(declarations, "text-decoration: "+strings.Join(decorations, " "))
+	}
+	return strings.Join(declarations, "; ")
+}
+
+// cssColor returns a color in the CSS #rrggbb notation.
+func cssColor(c Color) string {
+	r, g, b := c.RGB()
+	return fmt.Sprintf("#%02x%02x%02x", r, g, b)
+}
+
+// alignCSS returns the CSS text-align declaration of an alignment, or an empty string for Default.
+func alignCSS(align Alignment) string {
+	if align == Default {
+		return ""
+	}
+	return "text-align: " + align.String()
+}
+
+// htmlStyleAttr returns a style attribute with the non-empty CSS declarations, or an empty string if there are none.
+func htmlStyleAttr(declarations ...string) string {
+	var nonEmpty []string
+	for _, d := range declarations {
+		if d != "" {
+			nonEmpty = append(nonEmpty, d)
+		}
+	}
+	if len(nonEmpty) == 0 {
+		return ""
+	}
+	return ` style="` + html.EscapeString(strings.Join(nonEmpty, "; ")) + `"`
+}
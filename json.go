@@ -0,0 +1,181 @@
+package termhyo
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// jsonNumberRegex matches the numbers of JSON.
+var jsonNumberRegex = regexp.MustCompile(`^-?(0|[1-9]\d*)(\.\d+)?([eE][-+]?\d+)?$`)
+
+// JSONRenderer writes the table as a JSON array of objects, one per row, keyed by the column titles.
+// Rows are buffered until Render, and footer rows are written after the data rows.
+type JSONRenderer struct {
+	rendered bool
+	objects  []string // Objects of the rows added so far
+}
+
+// AddRow adds a row for JSON rendering.
+func (r *JSONRenderer) AddRow(table *Table, row Row) error {
+	if r.rendered {
+		return ErrAddAfterRender
+	}
+	r.objects = append(r.objects, jsonObject(table, row))
+	return nil
+}
+
+// Render writes the JSON array.
+func (r *JSONRenderer) Render(table *Table) error {
+	if r.rendered {
+		return ErrTableAlreadyRendered
+	}
+	if len(table.columns) == 0 {
+		return ErrNoColumns
+	}
+
+	objects := r.objects
+	for _, footer := range table.footers {
+		objects = append(objects, jsonObject(table, footer))
+	}
+
+	var b strings.Builder
+	b.WriteString("[")
+	for i, object := range objects {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		b.WriteString("\n  " + object)
+	}
+	if len(objects) > 0 {
+		b.WriteString("\n")
+	}
+	b.WriteString("]\n")
+
+	if _, err := table.writer.Write([]byte(b.String())); err != nil {
+		return err
+	}
+	r.rendered = true
+	return nil
+}
+
+// IsRendered returns whether the table has been rendered.
+func (r *JSONRenderer) IsRendered() bool {
+	return r.rendered
+}
+
+// JSONLinesRenderer writes the table as JSON Lines (NDJSON): one object per row, keyed by the column titles.
+// Each row is written as soon as it is added, and footer rows are written by Render.
+type JSONLinesRenderer struct {
+	rendered bool
+}
+
+// AddRow writes a row as a JSON object on a line.
+func (r *JSONLinesRenderer) AddRow(table *Table, row Row) error {
+	if r.rendered {
+		return ErrAddAfterRender
+	}
+	if len(table.columns) == 0 {
+		return ErrNoColumns
+	}
+	_, err := table.writer.Write([]byte(jsonObject(table, row) + "\n"))
+	return err
+}
+
+// Render writes the footer rows.
+func (r *JSONLinesRenderer) Render(table *Table) error {
+	if r.rendered {
+		return ErrTableAlreadyRendered
+	}
+	if len(table.columns) == 0 {
+		return ErrNoColumns
+	}
+	for _, footer := range table.footers {
+		if _, err := table.writer.Write([]byte(jsonObject(table, footer) + "\n")); err != nil {
+			return err
+		}
+	}
+	r.rendered = true
+	return nil
+}
+
+// IsRendered returns whether the table has been rendered.
+func (r *JSONLinesRenderer) IsRendered() bool {
+	return r.rendered
+}
+
+// jsonObject returns a row as a JSON object with the keys in column order.
+func jsonObject(table *Table, row Row) string {
+	keys := recordKeys(table)
+	var b strings.Builder
+	b.WriteString("{")
+	for i, field := range plainRecord(table, row) {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(jsonString(keys[i]) + ": " + jsonValue(field, table.columns[i].Type))
+	}
+	b.WriteString("}")
+	return b.String()
+}
+
+// recordKeys returns the keys of the objects written by the data formats.
+// The keys are the column titles without escape sequences, and a title that is
+// already used gets the 1-based column number (or the next free number) appended,
+// so the titles "Name", "Name" become the keys "Name", "Name_2".
+func recordKeys(table *Table) []string {
+	keys := make([]string, len(table.columns))
+	used := make(map[string]bool, len(table.columns))
+	for i, col := range table.columns {
+		key := plainText(col.Title)
+		for n := i + 1; used[key]; n++ {
+			key = plainText(col.Title) + "_" + strconv.Itoa(n)
+		}
+		used[key] = true
+		keys[i] = key
+	}
+	return keys
+}
+
+// jsonValue returns a field as a JSON value of the column type.
+// Empty fields of number and bool columns are null, and fields that are not valid values of the type are strings.
+func jsonValue(field string, typ ValueType) string {
+	value, ok := typedValue(field, typ)
+	if !ok {
+		return jsonString(field)
+	}
+	if value == "" {
+		return "null"
+	}
+	return value
+}
+
+// typedValue returns the canonical form of a field of a number or bool column,
+// or an empty string for an empty field.
+// It reports false for string columns and fields that are not valid values of the type.
+func typedValue(field string, typ ValueType) (string, bool) {
+	field = strings.TrimSpace(field)
+	switch {
+	case typ != TypeNumber && typ != TypeBool:
+		return "", false
+	case field == "":
+		return "", true
+	case typ == TypeNumber:
+		return field, jsonNumberRegex.MatchString(field)
+	default:
+		b, err := strconv.ParseBool(field)
+		return strconv.FormatBool(b), err == nil
+	}
+}
+
+// jsonString returns s as a JSON string.
+// Unlike json.Marshal, the characters <, > and & are not escaped.
+func jsonString(s string) string {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	_ = encoder.Encode(s) // Encoding a string cannot fail
+	return strings.TrimSuffix(buf.String(), "\n")
+}
@@ -0,0 +1,190 @@
+package termhyo
+
+import (
+	"strconv"
+	"strings"
+)
+
+// latexEscaper escapes the special characters of LaTeX.
+var latexEscaper = strings.NewReplacer(
+	`\`, `\textbackslash{}`,
+	"&", `\&`,
+	"%", `\%`,
+	"$", `\$`,
+	"#", `\#`,
+	"_", `\_`,
+	"{", `\{`,
+	"}", `\}`,
+	"~", `\textasciitilde{}`,
+	"^", `\textasciicircum{}`,
+)
+
+// LaTeXRenderer writes the table as a LaTeX tabular environment with the rules of the booktabs package.
+// The Top, Middle and Bottom flags of the border configuration give \toprule, \midrule and \bottomrule,
+// and the Left, Vertical and Right flags give vertical lines in the column specification.
+type LaTeXRenderer struct {
+	rendered bool
+	rows     []Row // Rows are buffered so that cells can span several rows
+}
+
+// AddRow adds a row for LaTeX rendering.
+func (r *LaTeXRenderer) AddRow(_ *Table, row Row) error {
+	if r.rendered {
+		return ErrAddAfterRender
+	}
+	r.rows = append(r.rows, row)
+	return nil
+}
+
+// Render writes the tabular environment.
+func (r *LaTeXRenderer) Render(table *Table) error {
+	if r.rendered {
+		return ErrTableAlreadyRendered
+	}
+	if len(table.columns) == 0 {
+		return ErrNoColumns
+	}
+	config := table.borderConfig
+
+	var b strings.Builder
+	b.WriteString(`\begin{tabular}{` + latexColumnSpec(table) + "}\n")
+	if config.Top {
+		b.WriteString(`\toprule` + "\n")
+	}
+
+	// Group titles are underlined by \cmidrule over the columns they span
+	writeLaTeXRows(&b, table, table.headerRows(), true, func(_ int, slots []cellSlot) {
+		for _, slot := range slots {
+			if !slot.covered && slot.span > 1 && slot.cell.Content != "" {
+				b.WriteString(`\cmidrule(lr){` + strconv.Itoa(slot.col+1) + "-" + strconv.Itoa(slot.col+slot.span) + "}\n")
+			}
+		}
+	})
+	if config.Middle {
+		b.WriteString(`\midrule` + "\n")
+	}
+
+	writeLaTeXRows(&b, table, r.rows, false, func(i int, _ []cellSlot) {
+		if table.separatorBefore(r.rows[i+1]) {
+			b.WriteString(`\midrule` + "\n")
+		}
+	})
+
+	// Footer rows follow the data rows, separated by a rule like in the other border styles
+	if len(table.footers) > 0 {
+		if config.Middle {
+			b.WriteString(`\midrule` + "\n")
+		}
+		writeLaTeXRows(&b, table, table.footers, false, nil)
+	}
+
+	if config.Bottom {
+		b.WriteString(`\bottomrule` + "\n")
+	}
+	b.WriteString(`\end{tabular}` + "\n")
+
+	if _, err := table.writer.Write([]byte(b.String())); err != nil {
+		return err
+	}
+	r.rendered = true
+	return nil
+}
+
+// IsRendered returns whether the table has been rendered.
+func (r *LaTeXRenderer) IsRendered() bool {
+	return r.rendered
+}
+
+// latexColumnSpec returns the column specification of the tabular environment, such as "lrc".
+func latexColumnSpec(table *Table) string {
+	config := table.borderConfig
+	var spec strings.Builder
+	if config.Left {
+		spec.WriteString("|")
+	}
+	for i, col := range table.columns {
+		if i > 0 && config.Vertical {
+			spec.WriteString("|")
+		}
+		spec.WriteString(latexAlign(col.Align))
+	}
+	if config.Right {
+		spec.WriteString("|")
+	}
+	return spec.String()
+}
+
+// latexAlign returns the column specification letter of an alignment.
+func latexAlign(align Alignment) string {
+	switch align {
+	case Right:
+		return "r"
+	case Center:
+		return "c"
+	default:
+		return "l"
+	}
+}
+
+// writeLaTeXRows writes rows of the tabular environment, calling between after each row but the last.
+// A spanned cell becomes \multicolumn, and a cell spanning rows is written in the row given by its
+// vertical alignment, since tabular cannot merge rows.
+// Single-column header cells keep the column alignment.
+func writeLaTeXRows(b *strings.Builder, table *Table, rows []Row, header bool, between func(r int, slots []cellSlot)) {
+	layouts := table.layoutRows(rows)
+	spanning := make(map[int]cellSlot) // Cells spanning rows by their first column
+	start := make(map[int]int)         // First row of the cells spanning rows by their first column
+	for r, slots := range layouts {
+		cells := make([]string, 0, len(slots))
+		for _, slot := range slots {
+			if !slot.covered {
+				spanning[slot.col], start[slot.col] = slot, r
+			}
+			cell := spanning[slot.col]
+			columnAlign := latexAlign(table.columns[slot.col].Align)
+			align := columnAlign
+			if cell.cell.Align != Default && (slot.span > 1 || !header) {
+				align = latexAlign(cell.cell.Align)
+			}
+
+			var content string
+			if !cell.empty && r-start[slot.col] == latexContentRow(cell) {
+				content = latexContent(cell.cell.Content, align)
+			}
+			if slot.span > 1 || align != columnAlign {
+				content = `\multicolumn{` + strconv.Itoa(slot.span) + "}{" + align + "}{" + content + "}"
+			}
+			cells = append(cells, content)
+		}
+		b.WriteString(strings.Join(cells, " & ") + ` \\` + "\n")
+		if between != nil && r < len(layouts)-1 {
+			between(r, slots)
+		}
+	}
+}
+
+// latexContentRow returns the row, relative to the first row, in which a cell spanning rows is written.
+func latexContentRow(slot cellSlot) int {
+	switch slot.cell.VAlign {
+	case AlignMiddle:
+		return (slot.rowSpan - 1) / 2
+	case AlignBottom:
+		return slot.rowSpan - 1
+	default:
+		return 0
+	}
+}
+
+// latexContent returns cell content with the special characters escaped and escape sequences removed.
+// Multi-line content is written as a nested tabular, since tabular cells cannot break lines,
+// without the trailing line breaks, which would add empty rows.
+func latexContent(s, align string) string {
+	lines := strings.Split(strings.TrimRight(plainText(s), "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = latexEscaper.Replace(line)
+	}
+	if len(lines) == 1 {
+		return lines[0]
+	}
+	return `\begin{tabular}[t]{@{}` + align + `@{}}` + strings.Join(lines, ` \\ `) + `\end{tabular}`
+}
@@ -0,0 +1,284 @@
+package termhyo
+
+import "strings"
+
+// cellSlot is a cell placed on the table columns it covers.
+type cellSlot struct {
+	cell    Cell
+	col     int   // index of the first covered column
+	span    int   // number of covered columns
+	rowSpan int   // number of covered rows
+	empty   bool  // true if the row has no cell for the column
+	covered bool  // true if the columns are covered by a cell spanning from a row above
+	style   Style // row style combined with the cell style
+}
+
+// layoutRow places the cells of a single row on the table columns.
+// Row spans are ignored, since there are no following rows to cover.
+func (t *Table) layoutRow(row Row) []cellSlot {
+	return t.layoutRows([]Row{row})[0]
+}
+
+// layoutRows places the cells of rows on the table columns.
+// A cell with Span covers that many adjacent columns, clipped at the last column
+// or at the columns covered by a cell spanning from a row above.
+// A cell with RowSpan covers the same columns in that many rows, clipped at the last row,
+// and the cells of the following rows are placed on the remaining columns.
+// Columns without a cell are filled with empty slots.
+func (t *Table) layoutRows(rows []Row) [][]cellSlot {
+	layouts := make([][]cellSlot, len(rows))
+	// Cells spanning rows, by their first column, and the number of following rows they still cover
+	spanning := make(map[int]cellSlot)
+	remaining := make(map[int]int)
+
+	for r, row := range rows {
+		slots := make([]cellSlot, 0, len(t.columns))
+		cells := row.Cells
+		for col := 0; col < len(t.columns); {
+			if slot, ok := spanning[col]; ok {
+				slots = append(slots, cellSlot{col: col, span: slot.span, rowSpan: 1, covered: true})
+				col += slot.span
+				continue
+			}
+			if len(cells) == 0 {
+				slots = append(slots, cellSlot{col: col, span: 1, rowSpan: 1, empty: true, style: row.Style})
+				col++
+				continue
+			}
+
+			cell := cells[0]
+			cells = cells[1:]
+			span := 1
+			for span < max(cell.Span, 1) && col+span < len(t.columns) {
+				if _, ok := spanning[col+span]; ok {
+					break
+				}
+				span++
+			}
+			rowSpan := min(max(cell.RowSpan, 1), len(rows)-r)
+			slots = append(slots, cellSlot{cell: cell, col: col, span: span, rowSpan: rowSpan, style: row.Style.Combine(cell.Style)})
+			col += span
+		}
+		layouts[r] = slots
+
+		// Count down the rows covered by spanning cells, and start the new ones
+		for col := range remaining {
+			remaining[col]--
+			if remaining[col] == 0 {
+				delete(remaining, col)
+				delete(spanning, col)
+			}
+		}
+		for _, slot := range slots {
+			if !slot.covered && slot.rowSpan > 1 {
+				spanning[slot.col] = slot
+				remaining[slot.col] = slot.rowSpan - 1
+			}
+		}
+	}
+	return layouts
+}
+
+// boundaries reports for each boundary between adjacent columns
+// whether it separates two slots, i.e. no cell spans across it.
+func (t *Table) boundaries(slots []cellSlot) []bool {
+	result := make([]bool, max(len(t.columns)-1, 0))
+	for _, slot := range slots {
+		if last := slot.col + slot.span - 1; last < len(result) {
+			result[last] = true
+		}
+	}
+	return result
+}
+
+// rowBoundaries returns the column boundaries of a row, or nil if row is nil.
+func (t *Table) rowBoundaries(row *Row) []bool {
+	if row == nil {
+		return nil
+	}
+	return t.boundaries(t.layoutRow(*row))
+}
+
+// isBoundary reports whether there is a column boundary after column i.
+// A nil boundaries slice means that every column is separated.
+func isBoundary(boundaries []bool, i int) bool {
+	return boundaries == nil || boundaries[i]
+}
+
+// separatorWidth returns the display width between the content areas of two adjacent columns.
+func (t *Table) separatorWidth() int {
+	width := 0
+	if t.borderConfig.Padding {
+		width += t.padding * 2
+	}
+	if t.borderConfig.Vertical {
+		width += stringWidth(t.borders["vertical"])
+	}
+	return width
+}
+
+// slotWidth returns the content width of a slot,
+// including the padding and separators of the column boundaries it spans.
+func (t *Table) slotWidth(slot cellSlot) int {
+	width := (slot.span - 1) * t.separatorWidth()
+	for _, col := range t.columns[slot.col : slot.col+slot.span] {
+		width += col.Width
+	}
+	return width
+}
+
+// distributeSpanWidth widens the auto-width columns covered by a spanned cell
+// so that its content fits, sharing the extra width evenly among them.
+func (t *Table) distributeSpanWidth(slot cellSlot, widths []int) {
+	var autoCols []int
+	available := (slot.span - 1) * t.separatorWidth()
+	for i := slot.col; i < slot.col+slot.span; i++ {
+		if t.columns[i].Width == 0 {
+			autoCols = append(autoCols, i)
+			available += widths[i]
+		} else {
+			available += t.columns[i].Width
+		}
+	}
+
+	extra := linesWidth(slot.cell.Content) - available
+	if extra <= 0 || len(autoCols) == 0 {
+		return
+	}
+	for k, i := range autoCols {
+		widths[i] += extra / len(autoCols)
+		if k < extra%len(autoCols) {
+			widths[i]++
+		}
+	}
+}
+
+// alignLines vertically aligns the lines of a cell within height lines, filling the rest with blank lines.
+func (t *Table) alignLines(lines []string, height int, slot cellSlot) []string {
+	if len(lines) >= height {
+		return lines
+	}
+
+	blank := t.blankCell(t.slotWidth(slot))
+	top := 0
+	switch slot.cell.VAlign {
+	case AlignMiddle:
+		top = (height - len(lines)) / 2
+	case AlignBottom:
+		top = height - len(lines)
+	}
+
+	result := make([]string, 0, height)
+	for range top {
+		result = append(result, blank)
+	}
+	result = append(result, lines...)
+	for len(result) < height {
+		result = append(result, blank)
+	}
+	return result
+}
+
+// groupSpan is a column group placed on the table columns.
+type groupSpan struct {
+	group ColumnGroup
+	col   int // index of the first covered column
+	span  int // number of covered columns
+}
+
+// layoutGroups places the column groups of each level on the table columns.
+// Columns not covered by a group are filled with single-column groups without a title.
+func (t *Table) layoutGroups() [][]groupSpan {
+	levels := make([][]groupSpan, len(t.headerGroups))
+	for l, groups := range t.headerGroups {
+		col := 0
+		for _, group := range groups {
+			if col >= len(t.columns) {
+				break
+			}
+			span := min(max(group.Span, 1), len(t.columns)-col)
+			levels[l] = append(levels[l], groupSpan{group: group, col: col, span: span})
+			col += span
+		}
+		for ; col < len(t.columns); col++ {
+			levels[l] = append(levels[l], groupSpan{col: col, span: 1})
+		}
+	}
+	return levels
+}
+
+// flatHeader reports whether column groups are flattened into the column titles,
+// for formats that cannot represent nested headers.
+func (t *Table) flatHeader() bool {
+	return t.borderStyle == MarkdownStyle || t.borderStyle == TSVStyle || t.borderStyle == CSVStyle
+}
+
+// headerTitle returns the title of the column at index i prefixed with the titles of its groups,
+// such as "Q1 Jan", for formats that cannot represent nested headers.
+func (t *Table) headerTitle(i int) string {
+	var titles []string
+	for _, level := range t.layoutGroups() {
+		for _, g := range level {
+			if g.group.Title != "" && g.col <= i && i < g.col+g.span {
+				titles = append(titles, g.group.Title)
+			}
+		}
+	}
+	return strings.Join(append(titles, t.columns[i].Title), " ")
+}
+
+// headerRows returns the header rows: one row for each level of column groups followed by the column titles.
+// The title of a column without group titles at the lowest levels spans upward over those levels.
+func (t *Table) headerRows() []Row {
+	if len(t.headerGroups) == 0 || t.flatHeader() {
+		cells := make([]Cell, len(t.columns))
+		for i := range t.columns {
+			cells[i] = Cell{Content: t.headerTitle(i), Align: Center}
+		}
+		return []Row{{Cells: cells}}
+	}
+
+	levels := t.layoutGroups()
+
+	// Topmost level from which each column has only single-column groups without a title
+	titleLevel := make([]int, len(t.columns))
+	for i := range titleLevel {
+		titleLevel[i] = len(levels)
+	}
+	for l := len(levels) - 1; l >= 0; l-- {
+		for _, g := range levels[l] {
+			if g.group.Title == "" && g.span == 1 && titleLevel[g.col] == l+1 {
+				titleLevel[g.col] = l
+			}
+		}
+	}
+
+	rows := make([]Row, len(levels)+1)
+	for l, level := range levels {
+		for _, g := range level {
+			switch {
+			case titleLevel[g.col] == l && g.span == 1:
+				rows[l].Cells = append(rows[l].Cells, Cell{
+					Content: t.columns[g.col].Title,
+					Align:   Center,
+					RowSpan: len(levels) - l + 1,
+					VAlign:  AlignBottom,
+				})
+			case titleLevel[g.col] < l && g.span == 1:
+				// Covered by the column title spanning from a level above
+			default:
+				align := g.group.Align
+				if align == Default {
+					align = Center
+				}
+				rows[l].Cells = append(rows[l].Cells, Cell{Content: g.group.Title, Align: align, Span: g.span})
+			}
+		}
+	}
+	for i, col := range t.columns {
+		if titleLevel[i] == len(levels) {
+			rows[len(levels)].Cells = append(rows[len(levels)].Cells, Cell{Content: col.Title, Align: Center})
+		}
+	}
+	return rows
+}
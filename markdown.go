@@ -1,6 +1,7 @@
 package termhyo
 
 import (
+	"slices"
 	"strings"
 )
 
@@ -23,6 +24,47 @@ func hasAutoWidth(table *Table) bool {
 	return false
 }
 
+// markdownRows returns copies of the rows that Markdown can represent.
+// Newlines in cells are replaced by line breaks, since Markdown rows cannot span several lines.
+// A spanned cell is rendered in its first row and column, and the other columns and rows it covers are left empty.
+func markdownRows(table *Table, rows []Row) []Row {
+	result := make([]Row, len(rows))
+	for r, slots := range table.layoutRows(rows) {
+		cells := make([]Cell, 0, len(table.columns))
+		for _, slot := range slots {
+			cell := Cell{}
+			if !slot.empty && !slot.covered {
+				cell = slot.cell
+				cell.Content = strings.Join(splitLines(table.outputContent(cell.Content)), "<br>")
+				if cell.Link != "" && cell.Content != "" {
+					cell.Content = markdownLink(cell.Content, cell.Link)
+				}
+				cell.Span, cell.RowSpan = 0, 0
+			}
+			cells = append(cells, cell)
+			for range slot.span - 1 {
+				cells = append(cells, Cell{})
+			}
+		}
+		result[r] = Row{Cells: cells}
+	}
+	return result
+}
+
+// markdownLinkText escapes the brackets in the text of a Markdown link.
+var markdownLinkText = strings.NewReplacer("[", `\[`, "]", `\]`)
+
+// markdownLinkURL percent-encodes the characters that end or break a Markdown link destination.
+var markdownLinkURL = strings.NewReplacer(
+	" ", "%20", "(", "%28", ")", "%29", "<", "%3C", ">", "%3E",
+	"|", "%7C", "\t", "%09", "\n", "%0A", "\r", "%0D",
+)
+
+// markdownLink returns a Markdown link to url with the brackets in text escaped and url percent-encoded where needed.
+func markdownLink(text, url string) string {
+	return "[" + markdownLinkText.Replace(text) + "](" + markdownLinkURL.Replace(url) + ")"
+}
+
 // AddRow adds a row for markdown rendering (buffered mode for width calculation).
 func (r *MarkdownRenderer) AddRow(_ *Table, row Row) error {
 	if r.rendered {
@@ -42,13 +84,25 @@ func (r *MarkdownRenderer) Render(table *Table) error {
 		return ErrTableAlreadyRendered
 	}
 
+	rows := markdownRows(table, r.bufferedRows)
+	// Footer rows are rendered as plain rows after the data rows
+	footers := markdownRows(table, table.footers)
+
 	// Calculate column widths if needed using all buffered rows
 	if hasAutoWidth(table) {
 		// Temporarily copy buffered rows to table for width calculation
-		originalRows := table.rows
-		table.rows = r.bufferedRows
+		// The title is rendered outside the table, so it does not widen the columns
+		originalRows, originalFooters, originalTitle := table.rows, table.footers, table.title
+		table.rows, table.footers, table.title = rows, footers, ""
 		table.CalculateColumnWidths()
-		table.rows = originalRows // Restore original rows
+		table.rows, table.footers, table.title = originalRows, originalFooters, originalTitle // Restore original rows
+	}
+
+	// Title as a paragraph before the table
+	if table.title != "" {
+		if _, err := table.writer.Write([]byte(table.outputContent(table.title) + "\n\n")); err != nil {
+			return err
+		}
 	}
 
 	// Render header and separator
@@ -59,13 +113,20 @@ func (r *MarkdownRenderer) Render(table *Table) error {
 		return err
 	}
 
-	// Render all buffered rows
-	for _, row := range r.bufferedRows {
+	// Render all buffered rows followed by the footer rows
+	for _, row := range slices.Concat(rows, footers) {
 		if err := r.renderMarkdownRow(table, row); err != nil {
 			return err
 		}
 	}
 
+	// Caption as a paragraph after the table
+	if table.caption != "" {
+		if _, err := table.writer.Write([]byte("\n" + table.outputContent(table.caption) + "\n")); err != nil {
+			return err
+		}
+	}
+
 	r.rendered = true
 	return nil
 }
@@ -80,21 +141,22 @@ func (r *MarkdownRenderer) renderMarkdownHeader(table *Table) error {
 	var line strings.Builder
 	var stylePrefix, styleSuffix string
 
-	// Apply header style to the entire line if configured
-	if !table.headerStyle.isEmpty() {
-		stylePrefix = table.headerStyle.getPrefix()
-		styleSuffix = table.headerStyle.getSuffix()
+	// Apply header style to the entire line if configured and styles are written
+	if headerStyle := table.outputStyle(table.headerStyle); !headerStyle.isEmpty() {
+		stylePrefix = headerStyle.getPrefix()
+		styleSuffix = headerStyle.getSuffix()
 	}
 
 	// Start the line with style prefix
 	line.WriteString(stylePrefix)
 	line.WriteString("|")
 
-	for _, col := range table.columns {
+	for i, col := range table.columns {
 		// Apply alignment to header content (headers are typically centered)
-		content := col.Title
+		// Column groups are flattened into the titles, since Markdown cannot represent nested headers
+		content := table.outputContent(table.headerTitle(i))
 		if table.autoAlign {
-			content = table.formatCell(col.Title, col.Width, Center)
+			content = table.formatCell(content, col.Width, Center)
 		}
 		line.WriteString(content)
 		line.WriteString("|")
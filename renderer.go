@@ -54,17 +54,23 @@ func (r *Buffered) Render(table *Table) error {
 	table.CalculateColumnWidths()
 
 	// Render all buffered content
-	if err := table.RenderHeader(); err != nil {
+	var next *Row
+	if len(table.rows) > 0 {
+		next = &table.rows[0]
+	} else if len(table.footers) > 0 {
+		next = &table.footers[0]
+	}
+	if err := table.renderHeader(next); err != nil {
 		return err
 	}
 
-	for _, row := range table.rows {
-		if err := table.RenderRow(row); err != nil {
-			return err
-		}
+	// Rows are rendered together so that cells can span several rows
+	separator := func(i int) bool { return table.separatorBefore(table.rows[i]) }
+	if err := table.renderRows(table.rows, rowStyle{stripes: table.stripes, columns: true}, separator); err != nil {
+		return err
 	}
 
-	if err := table.RenderFooter(); err != nil {
+	if err := table.renderFooter(len(table.rows) > 0); err != nil {
 		return err
 	}
 
@@ -81,6 +87,7 @@ func (r *Buffered) IsRendered() bool {
 type Streaming struct {
 	rendered   bool
 	headerDone bool
+	rowCount   int
 }
 
 // AddRow adds a row to the streaming renderer.
@@ -90,13 +97,24 @@ func (r *Streaming) AddRow(table *Table, row Row) error {
 	}
 
 	if !r.headerDone {
-		if err := table.RenderHeader(); err != nil {
+		if err := table.renderHeader(&row); err != nil {
 			return err
 		}
 		r.headerDone = true
 	}
 
-	return table.RenderRow(row)
+	// Separator line between rows (only if requested)
+	if r.rowCount > 0 && table.separatorBefore(row) {
+		if err := table.renderBorderLine("middle", table.rowBoundaries(&row)); err != nil {
+			return err
+		}
+	}
+
+	// The stripes continue from the rows rendered before
+	style := rowStyle{stripes: table.stripes, offset: r.rowCount, columns: true}
+	r.rowCount++
+
+	return table.renderRows([]Row{row}, style, nil)
 }
 
 // Render renders the streaming content, typically just the footer.
@@ -105,8 +123,20 @@ func (r *Streaming) Render(table *Table) error {
 		return ErrTableAlreadyRendered
 	}
 
+	// Render the header if no rows have been added
+	if !r.headerDone {
+		var next *Row
+		if len(table.footers) > 0 {
+			next = &table.footers[0]
+		}
+		if err := table.renderHeader(next); err != nil {
+			return err
+		}
+		r.headerDone = true
+	}
+
 	// For streaming mode, just render footer
-	if err := table.RenderFooter(); err != nil {
+	if err := table.renderFooter(r.rowCount > 0); err != nil {
 		return err
 	}
 
@@ -0,0 +1,44 @@
+package termhyo
+
+// Condition reports whether a rule applies to a data cell.
+// col is the index of the first column of the cell, title is the column title,
+// and content is the cell content without escape sequences.
+type Condition func(col int, title, content string) bool
+
+// Rule applies a style to the data cells matching its condition.
+// Rules are evaluated when the table is rendered, so the content does not need to contain escape sequences.
+type Rule struct {
+	Condition Condition // Condition of the cells to style
+	Style     Style     // Style applied to the matching cells
+}
+
+// ColumnEquals returns a condition matching the cells of the column with the given title whose content equals value.
+func ColumnEquals(title, value string) Condition {
+	return func(_ int, t, content string) bool {
+		return t == title && content == value
+	}
+}
+
+// styledCells reports whether cell styles are written.
+// Markdown, TSV and CSV are data formats, so their cells are written without styles.
+func (t *Table) styledCells() bool {
+	return t.borderStyle != MarkdownStyle && t.borderStyle != TSVStyle && t.borderStyle != CSVStyle
+}
+
+// cellStyle returns the style of a data cell: the column style, the row style, the cell style
+// and the styles of the matching rules, each taking precedence over the previous ones.
+func (t *Table) cellStyle(slot cellSlot) Style {
+	col := t.columns[slot.col]
+	style := col.Style.Combine(slot.style)
+	if slot.empty {
+		return style
+	}
+
+	content := stripEscapeSequences(slot.cell.Content)
+	for _, rule := range t.rules {
+		if rule.Condition != nil && rule.Condition(slot.col, col.Title, content) {
+			style = style.Combine(rule.Style)
+		}
+	}
+	return style
+}
@@ -3,6 +3,7 @@ package termhyo
 import (
 	"errors"
 	"io"
+	"slices"
 	"strings"
 )
 
@@ -19,6 +20,10 @@ var (
 	ErrAddAfterRender = errors.New("cannot add row after table has been rendered")
 )
 
+// defaultMinWidth is the minimum width of an auto-width column shrunk to fit the table width
+// when Column.MinWidth is not set. It leaves room for the "..." of truncated content.
+const defaultMinWidth = 3
+
 // TableOption is a functional option for configuring Table.
 type TableOption func(*Table)
 
@@ -26,6 +31,7 @@ type TableOption func(*Table)
 type Table struct {
 	columns      []Column
 	rows         []Row
+	footers      []Row
 	writer       io.Writer
 	mode         RenderMode
 	renderer     Renderer
@@ -34,7 +40,24 @@ type Table struct {
 	autoAlign    bool // If false, skip alignment for all columns
 	borders      map[string]string
 	padding      int
-	headerStyle  HeaderStyle // styling for header row
+	headerStyle  HeaderStyle  // styling for header row
+	footerStyle  HeaderStyle  // styling for footer rows
+	stripes      []Style      // styles alternating over the data rows
+	rules        []Rule       // conditional styles of the data cells
+	borderColor  Style        // styling for border characters
+	colorPolicy  ColorPolicy  // when styles are written
+	colorProfile ColorProfile // colors supported by the output
+	colors       bool         // whether styles are written, resolved from the color policy
+	profile      ColorProfile // color profile resolved from the color policy
+	maxWidth     int          // maximum table width including borders (0 = no limit)
+	ellipsis     string       // replaces the removed part of truncated content
+	lastBounds   []bool       // column boundaries of the last rendered row
+	headerGroups [][]ColumnGroup
+	title        string    // title rendered above the header
+	titleAlign   Alignment // alignment of the title (Default = Center)
+	caption      string    // caption rendered below the table
+	captionAlign Alignment // alignment of the caption (Default = Center)
+	separator    bool      // draw a separator line before the next row
 }
 
 // NewTable creates a new table with the given columns and optional configuration.
@@ -59,10 +82,12 @@ func NewTable(writer io.Writer, columns []Column, opts ...TableOption) *Table {
 		rows:         make([]Row, 0),
 		padding:      1,
 		autoAlign:    true, // Default to auto-aligning columns
+		ellipsis:     defaultEllipsis,
 		borderStyle:  BoxDrawingStyle,
 		borderConfig: borderConfig,
 		borders:      borderConfig.Chars,
 		headerStyle:  HeaderStyle{},
+		footerStyle:  HeaderStyle{},
 	}
 
 	// Apply options
@@ -70,21 +95,44 @@ func NewTable(writer io.Writer, columns []Column, opts ...TableOption) *Table {
 		opt(t)
 	}
 
+	// Resolve the color policy and profile for the writer
+	t.resolveColors()
+
 	// Determine render mode based on column configuration
 	t.mode = t.determineRenderMode()
 
 	// Set appropriate renderer based on mode and style
-	if t.borderStyle == MarkdownStyle {
-		t.renderer = &MarkdownRenderer{}
-	} else if t.mode == StreamingMode {
-		t.renderer = &Streaming{}
-	} else {
-		t.renderer = &Buffered{}
-	}
+	t.renderer = t.newRenderer()
 
 	return t
 }
 
+// newRenderer returns the renderer for the border style and render mode.
+func (t *Table) newRenderer() Renderer {
+	switch {
+	case t.borderStyle == MarkdownStyle:
+		return &MarkdownRenderer{}
+	case t.borderStyle == TSVStyle:
+		return &TSVRenderer{}
+	case t.borderStyle == CSVStyle:
+		return &CSVRenderer{}
+	case t.borderStyle == HTMLStyle:
+		return &HTMLRenderer{}
+	case t.borderStyle == JSONStyle:
+		return &JSONRenderer{}
+	case t.borderStyle == JSONLinesStyle:
+		return &JSONLinesRenderer{}
+	case t.borderStyle == YAMLStyle:
+		return &YAMLRenderer{}
+	case t.borderStyle == LaTeXStyle:
+		return &LaTeXRenderer{}
+	case t.mode == StreamingMode:
+		return &Streaming{}
+	default:
+		return &Buffered{}
+	}
+}
+
 // determineRenderMode decides whether to use buffered or streaming mode.
 func (t *Table) determineRenderMode() RenderMode {
 	hasAutoWidth := false
@@ -114,13 +162,67 @@ func (t *Table) AddRow(cells ...string) error {
 		row.Cells[i] = Cell{Content: content}
 	}
 
-	return t.renderer.AddRow(t, row)
+	return t.addRow(row)
 }
 
 // AddRowCells adds a row with detailed cell configuration.
 func (t *Table) AddRowCells(cells ...Cell) error {
 	row := Row{Cells: cells}
-	return t.renderer.AddRow(t, row)
+	return t.addRow(row)
+}
+
+// AddStyledRow adds a row of cells with a style applied to all of them.
+// Cell styles override the row style, and the row style overrides the column styles.
+func (t *Table) AddStyledRow(style Style, cells ...Cell) error {
+	row := Row{Cells: cells, Style: style}
+	return t.addRow(row)
+}
+
+// addRow passes a row to the renderer, marking it if a separator was requested before it.
+func (t *Table) addRow(row Row) error {
+	row.separator = t.separator
+	if err := t.renderer.AddRow(t, row); err != nil {
+		return err
+	}
+	t.separator = false
+	return nil
+}
+
+// AddSeparator adds a separator line between the rows added before and after it,
+// for example to divide the rows into groups.
+// The line is drawn with the middle border characters.
+// A separator before the first row or after the last row has no effect.
+func (t *Table) AddSeparator() error {
+	if t.renderer.IsRendered() {
+		return ErrAddAfterRender
+	}
+	t.separator = true
+	return nil
+}
+
+// separatorBefore reports whether a separator line is drawn before a data row, unless it is the first row.
+func (t *Table) separatorBefore(row Row) bool {
+	return t.borderConfig.RowSeparator || row.separator
+}
+
+// AddFooter adds a footer row (e.g. totals) to the table.
+// Footer rows are rendered after the data rows, separated by a middle border line.
+func (t *Table) AddFooter(cells ...string) error {
+	footerCells := make([]Cell, len(cells))
+	for i, content := range cells {
+		footerCells[i] = Cell{Content: content}
+	}
+
+	return t.AddFooterCells(footerCells...)
+}
+
+// AddFooterCells adds a footer row with detailed cell configuration.
+func (t *Table) AddFooterCells(cells ...Cell) error {
+	if t.renderer.IsRendered() {
+		return ErrAddAfterRender
+	}
+	t.footers = append(t.footers, Row{Cells: cells})
+	return nil
 }
 
 // Render renders the complete table.
@@ -141,24 +243,37 @@ func (t *Table) CalculateColumnWidths() {
 		return // All columns have fixed widths, no calculation needed
 	}
 
-	// Initialize max widths with header widths for auto-width columns
+	// Check the header, data and footer rows for accurate width calculation (row-oriented for better cache efficiency)
+	// Multi-line content is measured by its widest line
 	maxWidths := make([]int, len(t.columns))
-	for _, colIndex := range autoWidthColumns {
-		maxWidths[colIndex] = stringWidth(t.columns[colIndex].Title)
+	layouts := slices.Concat(t.layoutRows(t.headerRows()), t.layoutRows(t.rows), t.layoutRows(t.footers))
+	if titleRow := t.titleRow(); titleRow != nil {
+		layouts = append(layouts, t.layoutRow(*titleRow))
 	}
-
-	// Check all data rows for accurate width calculation (row-oriented for better cache efficiency)
-	for _, row := range t.rows {
-		for _, colIndex := range autoWidthColumns { // Only process auto-width columns
-			if colIndex < len(row.Cells) {
-				contentWidth := stringWidth(row.Cells[colIndex].Content)
-				if contentWidth > maxWidths[colIndex] {
-					maxWidths[colIndex] = contentWidth
+	var spanned []cellSlot
+	for _, slots := range layouts {
+		for _, slot := range slots {
+			if slot.empty || slot.covered {
+				continue
+			}
+			if slot.span > 1 {
+				spanned = append(spanned, slot)
+				continue
+			}
+			if t.columns[slot.col].Width == 0 { // Only process auto-width columns
+				contentWidth := linesWidth(slot.cell.Content)
+				if contentWidth > maxWidths[slot.col] {
+					maxWidths[slot.col] = contentWidth
 				}
 			}
 		}
 	}
 
+	// Widen the columns covered by spanned cells whose content does not fit
+	for _, slot := range spanned {
+		t.distributeSpanWidth(slot, maxWidths)
+	}
+
 	// Apply final width calculations
 	for _, colIndex := range autoWidthColumns {
 		maxWidth := maxWidths[colIndex]
@@ -170,40 +285,125 @@ func (t *Table) CalculateColumnWidths() {
 
 		t.columns[colIndex].Width = maxWidth
 	}
+
+	// Shrink auto-width columns to fit the table width limit
+	if t.maxWidth > 0 {
+		t.shrinkColumns(autoWidthColumns)
+	}
+}
+
+// tableWidth returns the display width of a table line, including borders and padding.
+func (t *Table) tableWidth() int {
+	width := 0
+	vertical := stringWidth(t.borders["vertical"])
+	if t.borderConfig.Left {
+		width += vertical
+	}
+	if t.borderConfig.Right {
+		width += vertical
+	}
+	if t.borderConfig.Padding {
+		width += t.padding * 2
+	}
+	width += (len(t.columns) - 1) * t.separatorWidth()
+	for _, col := range t.columns {
+		width += col.Width
+	}
+	return width
+}
+
+// shrinkColumns narrows auto-width columns so that the table fits within the maximum table width.
+// Each column gives up width in proportion to how far it is above its minimum width.
+// If the columns cannot shrink enough, they are narrowed to their minimum widths.
+func (t *Table) shrinkColumns(autoWidthColumns []int) {
+	excess := t.tableWidth() - t.maxWidth
+	if excess <= 0 {
+		return
+	}
+
+	// Width each column can give up without going below its minimum width
+	shrinkable := make([]int, len(autoWidthColumns))
+	total := 0
+	for i, colIndex := range autoWidthColumns {
+		col := t.columns[colIndex]
+		minWidth := col.MinWidth
+		if minWidth <= 0 {
+			minWidth = defaultMinWidth
+		}
+		shrinkable[i] = max(col.Width-minWidth, 0)
+		total += shrinkable[i]
+	}
+	if total == 0 {
+		return
+	}
+	excess = min(excess, total)
+
+	// Shrink proportionally, then take the rounding remainder from the widest margins
+	remaining := excess
+	for i, colIndex := range autoWidthColumns {
+		cut := excess * shrinkable[i] / total
+		t.columns[colIndex].Width -= cut
+		shrinkable[i] -= cut
+		remaining -= cut
+	}
+	for ; remaining > 0; remaining-- {
+		widest := 0
+		for i := range shrinkable {
+			if shrinkable[i] > shrinkable[widest] {
+				widest = i
+			}
+		}
+		t.columns[autoWidthColumns[widest]].Width--
+		shrinkable[widest]--
+	}
 }
 
 // RenderHeader renders the table header row, including the top border and header separator line if enabled.
 func (t *Table) RenderHeader() error {
+	return t.renderHeader(nil)
+}
+
+// renderHeader renders the table header.
+// The header separator line joins the column boundaries of the next row, if it is known.
+func (t *Table) renderHeader(next *Row) error {
 	if len(t.columns) == 0 {
 		return ErrNoColumns
 	}
+	headerRows := t.headerRows()
+	first := &headerRows[0]
+	titleRow := t.titleRow()
+	if titleRow != nil {
+		first = titleRow
+	}
+
 	// Top border (only if enabled)
 	if t.borderConfig.Top {
-		if err := t.RenderBorderLine("top"); err != nil {
+		if err := t.renderBorderLine("top", t.rowBoundaries(first)); err != nil {
 			return err
 		}
 	}
 
-	// Header row
-	headerRow := Row{
-		Cells: make([]Cell, len(t.columns)),
-	}
-
-	for i, col := range t.columns {
-		headerRow.Cells[i] = Cell{
-			Content: col.Title,
-			Align:   Center,
+	// Title row inside the top frame, joined to the header by a separator line
+	if titleRow != nil {
+		if err := t.renderRows([]Row{*titleRow}, rowStyle{}, nil); err != nil {
+			return err
+		}
+		if t.borderConfig.Middle {
+			if err := t.renderBorderLine("middle", t.rowBoundaries(&headerRows[0])); err != nil {
+				return err
+			}
 		}
 	}
 
-	// Use specialized header row rendering
-	if err := t.RenderHeaderRow(headerRow); err != nil {
+	// Header rows, separated between the levels of column groups
+	separator := func(int) bool { return t.borderConfig.Middle }
+	if err := t.renderRows(headerRows, rowStyle{line: t.headerStyle}, separator); err != nil {
 		return err
 	}
 
 	// Header separator (only if enabled)
 	if t.borderConfig.Middle {
-		return t.RenderBorderLine("middle")
+		return t.renderBorderLine("middle", t.rowBoundaries(next))
 	}
 
 	return nil
@@ -211,112 +411,279 @@ func (t *Table) RenderHeader() error {
 
 // RenderHeaderRow renders a header row with full-line styling.
 func (t *Table) RenderHeaderRow(row Row) error {
-	var builder strings.Builder
-	var stylePrefix, styleSuffix string
-
 	// Apply header style to the entire line if configured
-	if !t.headerStyle.isEmpty() {
-		stylePrefix = t.headerStyle.getPrefix()
-		styleSuffix = t.headerStyle.getSuffix()
-	}
-
-	// Start the line with style prefix
-	builder.WriteString(stylePrefix)
+	return t.renderRows([]Row{row}, rowStyle{line: t.headerStyle}, nil)
+}
 
-	// Cache vertical border string
-	vertical := t.borders["vertical"]
+// RenderFooterRow renders a footer row with full-line styling.
+func (t *Table) RenderFooterRow(row Row) error {
+	// Apply footer style to the entire line if configured
+	return t.renderRows([]Row{row}, rowStyle{line: t.footerStyle, columns: true}, nil)
+}
 
-	// Left border (only if enabled)
-	if t.borderConfig.Left {
-		builder.WriteString(vertical)
+// getCellLines returns the formatted lines for a cell placed on its columns.
+// A cell is rendered on several lines when its content contains newlines
+// or its column wraps overflowing content.
+func (t *Table) getCellLines(slot cellSlot) []string {
+	width := t.slotWidth(slot)
+	if slot.empty {
+		return []string{t.blankCell(width)}
 	}
 
-	for i, col := range t.columns {
-		content := t.getCellContent(row, i, col)
-		builder.WriteString(content)
-
-		// Add vertical separator between columns (only if enabled and not the last column)
-		if t.borderConfig.Vertical && i < len(t.columns)-1 {
-			builder.WriteString(vertical)
-		}
+	cell := slot.cell
+	content := t.outputContent(cell.Content)
+	if !t.autoAlign {
+		return splitLines(content) // No alignment, return raw content
 	}
 
-	// Right border (only if enabled)
-	if t.borderConfig.Right {
-		builder.WriteString(vertical)
+	col := t.columns[slot.col]
+	align := col.Align
+	if cell.Align != Default {
+		align = cell.Align
 	}
 
-	// End the line with style suffix
-	builder.WriteString(styleSuffix)
-	builder.WriteString("\n")
-
-	_, err := t.writer.Write([]byte(builder.String()))
-	return err
+	var lines []string
+	for _, line := range splitLines(content) {
+		lines = append(lines, fitString(line, width, col.Overflow, t.ellipsis)...)
+	}
+	for j, line := range lines {
+		lines[j] = t.formatCell(t.linkContent(line, cell.Link), width, align)
+	}
+	return lines
 }
 
-// getCellContent returns the formatted content for a cell in a header row and column.
-func (t *Table) getCellContent(row Row, i int, col Column) string {
-	if i < len(row.Cells) {
-		cell := row.Cells[i]
-		if !t.autoAlign {
-			return cell.Content // No alignment, return raw content
-		}
-
-		align := col.Align
-		if cell.Align != Default {
-			align = cell.Align
-		}
-		return t.formatCell(cell.Content, col.Width, align)
+// linkContent makes a line of cell content a hyperlink to url.
+// Each line is a separate hyperlink, so that the padding and borders are not part of the link.
+// Links are written only when escape sequences are written.
+func (t *Table) linkContent(line, url string) string {
+	if url == "" || line == "" || !t.colors || !t.styledCells() {
+		return line
 	}
+	return hyperlink(line, url)
+}
 
+// blankCell returns the content of an empty cell of the given width.
+func (t *Table) blankCell(width int) string {
 	if !t.autoAlign {
 		return ""
 	}
 	if !t.borderConfig.Padding {
 		// No padding for empty cells
-		return strings.Repeat(" ", col.Width)
+		return strings.Repeat(" ", width)
 	}
 	// Empty cell with padding using strings.Builder
 	var builder strings.Builder
 	paddingStr := strings.Repeat(" ", t.padding)
 	builder.WriteString(paddingStr)
-	builder.WriteString(strings.Repeat(" ", col.Width))
+	builder.WriteString(strings.Repeat(" ", width))
 	builder.WriteString(paddingStr)
 	return builder.String()
 }
 
 // RenderRow renders a single row.
 func (t *Table) RenderRow(row Row) error {
-	var builder strings.Builder
+	return t.renderRows([]Row{row}, rowStyle{columns: true}, nil)
+}
 
-	// Cache vertical border string
-	vertical := t.borders["vertical"]
+// rowStyle is the styling of rows rendered together.
+type rowStyle struct {
+	line    Style   // Style of each physical line, including the borders
+	stripes []Style // Styles cycling over the rows, combined with the line style
+	offset  int     // Position of the first row in the stripes
+	columns bool    // Whether the column styles and rules apply to the cells
+}
 
-	// Left border (only if enabled)
-	if t.borderConfig.Left {
-		builder.WriteString(vertical)
+// lineStyle returns the style of the physical lines of the row at the given index.
+func (s rowStyle) lineStyle(r int) Style {
+	if len(s.stripes) == 0 {
+		return s.line
 	}
+	return s.line.Combine(s.stripes[(s.offset+r)%len(s.stripes)])
+}
 
-	for i, col := range t.columns {
-		content := t.getCellContent(row, i, col)
-		builder.WriteString(content)
+// renderRows renders rows laid out together, so that cells can span several rows.
+// Each row is rendered as one or more physical lines, and cells with fewer lines
+// than the tallest cell in the row are padded with blank lines.
+// The line style is applied to each physical line, and the cell styles inside the padded cells.
+// Separator lines take the line style without the stripes.
+// separator reports whether a middle border line is drawn before the row at the given index;
+// the line is interrupted under cells spanning across it. A nil separator draws no lines.
+func (t *Table) renderRows(rows []Row, style rowStyle, separator func(r int) bool) error {
+	style.line = t.outputStyle(style.line)
+	stripes := make([]Style, len(style.stripes))
+	for i, stripe := range style.stripes {
+		stripes[i] = t.outputStyle(stripe)
+	}
+	style.stripes = stripes
+	stylePrefix, styleSuffix := style.line.getPrefix(), style.line.getSuffix()
+	layouts := t.layoutRows(rows)
+	if len(layouts) == 0 {
+		return nil
+	}
 
-		// Add vertical separator between columns (only if enabled and not the last column)
-		if t.borderConfig.Vertical && i < len(t.columns)-1 {
-			builder.WriteString(vertical)
+	// Format the cells and find the number of lines of each row
+	lines := make([][][]string, len(layouts))
+	heights := make([]int, len(layouts))
+	for r, slots := range layouts {
+		lines[r] = make([][]string, len(slots))
+		heights[r] = 1
+		for i, slot := range slots {
+			if slot.covered {
+				continue
+			}
+			lines[r][i] = t.getCellLines(slot)
+			if slot.rowSpan == 1 {
+				heights[r] = max(heights[r], len(lines[r][i]))
+			}
 		}
 	}
 
-	// Right border (only if enabled)
-	if t.borderConfig.Right {
-		builder.WriteString(vertical)
+	// Separator lines before each row; cells spanning across them show content there
+	separated := make([]int, len(layouts))
+	for r := 1; r < len(layouts); r++ {
+		if separator != nil && separator(r) {
+			separated[r] = 1
+		}
 	}
 
-	builder.WriteString("\n")
+	// spanHeight returns the number of lines covered by a cell spanning rows from r to last
+	spanHeight := func(r, last int) int {
+		return sumInts(heights[r:last+1]) + sumInts(separated[r+1:last+1])
+	}
+
+	// Make room for cells spanning rows in the last row they cover
+	for r, slots := range layouts {
+		for i, slot := range slots {
+			if slot.covered || slot.rowSpan == 1 {
+				continue
+			}
+			last := r + slot.rowSpan - 1
+			if extra := len(lines[r][i]) - spanHeight(r, last); extra > 0 {
+				heights[last] += extra
+			}
+		}
+	}
+
+	var builder strings.Builder
+	spanLines := make(map[int][]string) // Remaining lines of cells spanning rows, by column
+	for r, slots := range layouts {
+		if separated[r] > 0 {
+			segments := make(map[int]spanSegment)
+			for _, slot := range slots {
+				if slot.covered {
+					segments[slot.col] = spanSegment{span: slot.span, line: spanLines[slot.col][0]}
+					spanLines[slot.col] = spanLines[slot.col][1:]
+				}
+			}
+			t.writeBorderLine(&builder, "middle", t.boundaries(layouts[r-1]), t.boundaries(slots), segments, stylePrefix, styleSuffix)
+		}
+
+		cells := make([][]string, len(slots))
+		for i, slot := range slots {
+			if !slot.covered {
+				cellStyle := slot.style
+				if style.columns {
+					cellStyle = t.cellStyle(slot)
+				}
+				cellStyle = t.outputStyle(cellStyle)
+				if !t.styledCells() {
+					cellStyle = Style{}
+				}
+				if slot.rowSpan == 1 {
+					cells[i] = styleLines(t.alignLines(lines[r][i], heights[r], slot), cellStyle)
+					continue
+				}
+				height := spanHeight(r, r+slot.rowSpan-1)
+				spanLines[slot.col] = styleLines(t.alignLines(lines[r][i], height, slot), cellStyle)
+			}
+			cells[i] = spanLines[slot.col][:heights[r]]
+			spanLines[slot.col] = spanLines[slot.col][heights[r]:]
+		}
+		lineStyle := style.lineStyle(r)
+		t.writeLines(&builder, slots, cells, heights[r], lineStyle.getPrefix(), lineStyle.getSuffix())
+	}
+
+	t.lastBounds = t.boundaries(layouts[len(layouts)-1])
 	_, err := t.writer.Write([]byte(builder.String()))
 	return err
 }
 
+// styleLines applies a cell style to the formatted lines of a cell, including the padding.
+// Resets in the content re-open the cell style.
+func styleLines(lines []string, style Style) []string {
+	if style.isEmpty() {
+		return lines
+	}
+	prefix, suffix := style.getPrefix(), style.getSuffix()
+	styled := make([]string, len(lines))
+	for j, line := range lines {
+		if line == "" {
+			continue // Nothing to style without alignment
+		}
+		styled[j] = prefix + reopenStyle(line, prefix) + suffix
+	}
+	return styled
+}
+
+// writeLines writes the physical lines of a row with its borders.
+func (t *Table) writeLines(builder *strings.Builder, slots []cellSlot, cells [][]string, height int, stylePrefix, styleSuffix string) {
+	// Cache vertical border string, re-opening the line style after it
+	vertical := t.paintBorder(t.borders["vertical"], stylePrefix)
+
+	for line := range height {
+		// Start the line with style prefix
+		builder.WriteString(stylePrefix)
+
+		// Left border (only if enabled)
+		if t.borderConfig.Left {
+			builder.WriteString(vertical)
+		}
+
+		for i, slot := range slots {
+			if line < len(cells[i]) {
+				// Re-open the line style after resets in the cell
+				builder.WriteString(reopenStyle(cells[i][line], stylePrefix))
+			} else {
+				builder.WriteString(t.blankCell(t.slotWidth(slot)))
+			}
+
+			// Add vertical separator between cells (only if enabled and not the last cell)
+			if t.borderConfig.Vertical && i < len(slots)-1 {
+				builder.WriteString(vertical)
+			}
+		}
+
+		// Right border (only if enabled), the line style ends after it
+		if t.borderConfig.Right {
+			builder.WriteString(t.paintBorder(t.borders["vertical"], ""))
+		}
+
+		// End the line with style suffix
+		builder.WriteString(styleSuffix)
+		builder.WriteString("\n")
+	}
+}
+
+// paintBorder applies the border style to border characters.
+// The line style active before the characters is re-opened after them,
+// so that the border style does not bleed into the cells.
+func (t *Table) paintBorder(chars, linePrefix string) string {
+	style := t.outputStyle(t.borderColor)
+	if chars == "" || style.isEmpty() || !t.styledCells() {
+		return chars
+	}
+	return style.getPrefix() + chars + style.getSuffix() + linePrefix
+}
+
+// sumInts returns the sum of the values.
+func sumInts(values []int) int {
+	sum := 0
+	for _, v := range values {
+		sum += v
+	}
+	return sum
+}
+
 // formatCell formats cell content with alignment and padding.
 func (t *Table) formatCell(content string, width int, align Alignment) string {
 	contentWidth := stringWidth(content)
@@ -324,7 +691,7 @@ func (t *Table) formatCell(content string, width int, align Alignment) string {
 	if !t.borderConfig.Padding {
 		// No padding, use original behavior
 		if contentWidth > width {
-			content = truncateString(content, width)
+			content = truncate(content, width, t.ellipsis, OverflowTruncate)
 		}
 		return padString(content, width, align)
 	}
@@ -332,7 +699,7 @@ func (t *Table) formatCell(content string, width int, align Alignment) string {
 	// When padding is enabled, the cell width is "content width + padding on both sides"
 	// Truncate if content is too long for the specified width
 	if contentWidth > width {
-		content = truncateString(content, width)
+		content = truncate(content, width, t.ellipsis, OverflowTruncate)
 	}
 
 	// Apply alignment to the content width
@@ -349,65 +716,185 @@ func (t *Table) formatCell(content string, width int, align Alignment) string {
 
 // RenderBorderLine renders horizontal border lines.
 func (t *Table) RenderBorderLine(position string) error {
+	return t.renderBorderLine(position, nil)
+}
+
+// renderBorderLine renders a horizontal border line between the last rendered row and the next row.
+// Junctions are chosen from the column boundaries above and below the line,
+// so that cells spanning several columns are not crossed by vertical separators.
+func (t *Table) renderBorderLine(position string, below []bool) error {
 	var builder strings.Builder
+	t.writeBorderLine(&builder, position, t.lastBounds, below, nil, "", "")
+	_, err := t.writer.Write([]byte(builder.String()))
+	return err
+}
+
+// spanSegment is the part of a border line covered by a cell spanning across the line.
+type spanSegment struct {
+	span int    // number of covered columns
+	line string // content line of the cell shown in place of the border
+}
+
+// writeBorderLine writes a horizontal border line.
+// above and below are the column boundaries of the rows above and below the line,
+// where nil means that every column is separated.
+// segments holds the cells spanning across the line by their first column;
+// their content is shown instead of the border, surrounded by the style prefix and suffix.
+// The border characters are painted with the border style.
+func (t *Table) writeBorderLine(builder *strings.Builder, position string, above, below []bool, segments map[int]spanSegment, stylePrefix, styleSuffix string) {
+	vertical := t.borders["vertical"]
+	var border strings.Builder // Border characters not yet written
 
 	// left border (only if enabled)
 	if t.borderConfig.Left {
-		switch position {
-		case "top":
-			builder.WriteString(t.borders["top_left"])
-		case "bottom":
-			builder.WriteString(t.borders["bottom_left"])
+		_, open := segments[0]
+		switch {
+		case open:
+			border.WriteString(vertical)
+		case position == "top":
+			border.WriteString(t.borders["top_left"])
+		case position == "bottom":
+			border.WriteString(t.borders["bottom_left"])
 		default:
-			builder.WriteString(t.borders["left_cross"])
+			border.WriteString(t.borders["left_cross"])
 		}
 	}
 
-	for i, col := range t.columns {
-		// Calculate the actual cell width (content + padding)
-		cellWidth := col.Width
-		if t.borderConfig.Padding {
-			cellWidth += (t.padding * 2)
-		}
-		builder.WriteString(strings.Repeat(t.borders["horizontal"], cellWidth))
-
+	prevOpen := false
+	for i := 0; i < len(t.columns); {
+		segment, open := segments[i]
 		// Draw vertical separator between columns only if enabled
-		if t.borderConfig.Vertical && i < len(t.columns)-1 {
-			switch position {
-			case "top":
-				builder.WriteString(t.borders["top_cross"])
-			case "bottom":
-				builder.WriteString(t.borders["bottom_cross"])
+		if i > 0 && t.borderConfig.Vertical {
+			switch {
+			case prevOpen && open:
+				border.WriteString(vertical)
+			case prevOpen:
+				border.WriteString(t.borders["left_cross"])
+			case open:
+				border.WriteString(t.borders["right_cross"])
 			default:
-				builder.WriteString(t.borders["cross"])
+				up := position != "top" && isBoundary(above, i-1)
+				down := position != "bottom" && isBoundary(below, i-1)
+				border.WriteString(t.junction(up, down))
 			}
 		}
+
+		if open {
+			builder.WriteString(t.paintBorder(border.String(), ""))
+			border.Reset()
+			builder.WriteString(stylePrefix)
+			builder.WriteString(segment.line)
+			builder.WriteString(styleSuffix)
+			i += segment.span
+		} else {
+			// Calculate the actual cell width (content + padding)
+			cellWidth := t.columns[i].Width
+			if t.borderConfig.Padding {
+				cellWidth += (t.padding * 2)
+			}
+			border.WriteString(strings.Repeat(t.borders["horizontal"], cellWidth))
+			i++
+		}
+		prevOpen = open
 	}
 
 	// right border (only if enabled)
 	if t.borderConfig.Right {
-		switch position {
-		case "top":
-			builder.WriteString(t.borders["top_right"])
-		case "bottom":
-			builder.WriteString(t.borders["bottom_right"])
+		switch {
+		case prevOpen:
+			border.WriteString(vertical)
+		case position == "top":
+			border.WriteString(t.borders["top_right"])
+		case position == "bottom":
+			border.WriteString(t.borders["bottom_right"])
 		default:
-			builder.WriteString(t.borders["right_cross"])
+			border.WriteString(t.borders["right_cross"])
 		}
 	}
 
+	builder.WriteString(t.paintBorder(border.String(), ""))
 	builder.WriteString("\n")
-	_, err := t.writer.Write([]byte(builder.String()))
-	return err
 }
 
-// RenderFooter renders the table footer.
+// junction returns the border character where a horizontal line meets a column boundary.
+// up and down report whether the vertical separator continues above and below the line.
+func (t *Table) junction(up, down bool) string {
+	switch {
+	case up && down:
+		return t.borders["cross"]
+	case up:
+		return t.borders["bottom_cross"]
+	case down:
+		return t.borders["top_cross"]
+	default:
+		return strings.Repeat(t.borders["horizontal"], stringWidth(t.borders["vertical"]))
+	}
+}
+
+// RenderFooter renders the table footer, including the footer rows and the bottom border if enabled.
 func (t *Table) RenderFooter() error {
+	return t.renderFooter(true)
+}
+
+// renderFooter renders the table footer.
+// The footer separator is drawn only after data rows, since the header separator already precedes the footer rows
+// of a table without data rows.
+func (t *Table) renderFooter(afterRows bool) error {
+	if len(t.footers) > 0 {
+		// Footer separator (only if enabled)
+		if t.borderConfig.Middle && afterRows {
+			if err := t.renderBorderLine("middle", t.rowBoundaries(&t.footers[0])); err != nil {
+				return err
+			}
+		}
+
+		// Footer rows are rendered together so that cells can span several rows
+		if err := t.renderRows(t.footers, rowStyle{line: t.footerStyle, columns: true}, nil); err != nil {
+			return err
+		}
+	}
+
 	// Bottom border (only if enabled)
 	if t.borderConfig.Bottom {
-		return t.RenderBorderLine("bottom")
+		if err := t.RenderBorderLine("bottom"); err != nil {
+			return err
+		}
 	}
-	return nil
+
+	return t.renderCaption()
+}
+
+// titleRow returns the title row spanning all columns, or nil if the table has no title.
+func (t *Table) titleRow() *Row {
+	if t.title == "" {
+		return nil
+	}
+	align := t.titleAlign
+	if align == Default {
+		align = Center
+	}
+	return &Row{Cells: []Cell{{Content: t.title, Align: align, Span: len(t.columns)}}}
+}
+
+// renderCaption renders the caption lines below the table, aligned within the table width.
+func (t *Table) renderCaption() error {
+	if t.caption == "" {
+		return nil
+	}
+
+	align := t.captionAlign
+	if align == Default {
+		align = Center
+	}
+
+	var builder strings.Builder
+	width := t.tableWidth()
+	for _, line := range splitLines(t.outputContent(t.caption)) {
+		builder.WriteString(strings.TrimRight(padString(line, width, align), " "))
+		builder.WriteString("\n")
+	}
+	_, err := t.writer.Write([]byte(builder.String()))
+	return err
 }
 
 // Border sets the border style (option).
@@ -440,6 +927,126 @@ func Header(style HeaderStyle) TableOption {
 	}
 }
 
+// MaxTableWidth limits the total table width, including borders and padding (option).
+// Auto-width columns are shrunk proportionally to fit, but not below their minimum width.
+// A width of 0 means no limit.
+//
+// Example:
+//
+//	table := termhyo.NewTable(os.Stdout, columns, termhyo.MaxTableWidth(termhyo.TerminalWidth(os.Stdout)))
+func MaxTableWidth(width int) TableOption {
+	return func(t *Table) {
+		t.maxWidth = width
+	}
+}
+
+// Ellipsis sets the string that replaces the removed part of truncated content (option).
+// The default is "...". An empty string truncates without an ellipsis.
+//
+// Example:
+//
+//	table := termhyo.NewTable(os.Stdout, columns, termhyo.Ellipsis("…"))
+func Ellipsis(ellipsis string) TableOption {
+	return func(t *Table) {
+		t.ellipsis = ellipsis
+	}
+}
+
+// HeaderGroups adds a level of column groups above the column titles (option).
+// Each call adds a level below the levels added before, so the first call is the topmost level.
+// Columns not covered by a group, or covered by a group without a title, have their title extended upward.
+//
+// Example:
+//
+//	termhyo.HeaderGroups(termhyo.ColumnGroup{Title: "Name"}, termhyo.ColumnGroup{Title: "Q1", Span: 3})
+func HeaderGroups(groups ...ColumnGroup) TableOption {
+	return func(t *Table) {
+		t.headerGroups = append(t.headerGroups, groups)
+	}
+}
+
+// Title sets the table title rendered above the header, inside the top border (option).
+func Title(title string) TableOption {
+	return func(t *Table) {
+		t.title = title
+	}
+}
+
+// TitleAlign sets the alignment of the table title (option).
+func TitleAlign(align Alignment) TableOption {
+	return func(t *Table) {
+		t.titleAlign = align
+	}
+}
+
+// Caption sets the table caption rendered below the bottom border (option).
+func Caption(caption string) TableOption {
+	return func(t *Table) {
+		t.caption = caption
+	}
+}
+
+// CaptionAlign sets the alignment of the table caption (option).
+func CaptionAlign(align Alignment) TableOption {
+	return func(t *Table) {
+		t.captionAlign = align
+	}
+}
+
+// Footer sets the footer row style (option).
+func Footer(style HeaderStyle) TableOption {
+	return func(t *Table) {
+		t.footerStyle = style
+	}
+}
+
+// Stripes sets styles alternating over the data rows (option).
+// The styles are applied to the whole lines of the rows, usually with background colors:
+//
+//	termhyo.Stripes(termhyo.Style{}, termhyo.Style{BackgroundColor: termhyo.BgRGB256(236)})
+func Stripes(styles ...Style) TableOption {
+	return func(t *Table) {
+		t.stripes = styles
+	}
+}
+
+// BorderColor sets the style of the border characters (option).
+// Colors and attributes such as Dim apply to all border and junction characters, not to the cells.
+func BorderColor(style Style) TableOption {
+	return func(t *Table) {
+		t.borderColor = style
+	}
+}
+
+// ColorOutput sets when styles are written (option).
+// With ColorAuto, styles are only written to terminals, honoring NO_COLOR, CLICOLOR_FORCE and TERM=dumb:
+//
+//	table := termhyo.NewTable(os.Stdout, columns, termhyo.ColorOutput(termhyo.ColorAuto))
+func ColorOutput(policy ColorPolicy) TableOption {
+	return func(t *Table) {
+		t.colorPolicy = policy
+	}
+}
+
+// WithColorProfile sets the colors supported by the output (option).
+// Colors are converted to the nearest supported color before writing.
+// With ColorAuto, the profile is also limited to the one detected by DetectColorProfile.
+func WithColorProfile(profile ColorProfile) TableOption {
+	return func(t *Table) {
+		t.colorProfile = profile
+	}
+}
+
+// Rules sets the rules styling the data cells by their content (option).
+// Later rules take precedence over earlier ones, and all of them over the column, row and cell styles:
+//
+//	termhyo.Rules(termhyo.Rule{Condition: termhyo.ColumnEquals("Status", "FAIL"), Style: termhyo.Style{ForegroundColor: termhyo.AnsiRed}})
+func Rules(rules ...Rule) TableOption {
+	return func(t *Table) {
+		t.rules = rules
+	}
+}
+
 // AutoAlign sets the align flag (option).
 func AutoAlign(autoAlign bool) TableOption {
 	return func(t *Table) {
@@ -459,13 +1066,7 @@ func (t *Table) SetAutoAlign(autoAlign bool) {
 	t.mode = t.determineRenderMode()
 
 	// Update renderer based on new mode
-	if t.borderStyle == MarkdownStyle {
-		t.renderer = &MarkdownRenderer{}
-	} else if t.mode == StreamingMode {
-		t.renderer = &Streaming{}
-	} else {
-		t.renderer = &Buffered{}
-	}
+	t.renderer = t.newRenderer()
 }
 
 // GetAutoAlign returns the current auto-align setting.
@@ -483,6 +1084,9 @@ func (t *Table) SetBorderStyle(style BorderStyle) {
 	t.borderStyle = style
 	t.borderConfig = GetBorderConfig(style)
 	t.borders = t.borderConfig.Chars
+
+	// Data formats such as CSV have their own renderers
+	t.renderer = t.newRenderer()
 }
 
 // GetBorderStyle returns the current border style.
@@ -506,6 +1110,68 @@ func (t *Table) GetHeaderStyle() HeaderStyle {
 	return t.headerStyle
 }
 
+// SetFooterStyle sets the styling for footer rows.
+func (t *Table) SetFooterStyle(style HeaderStyle) {
+	t.footerStyle = style
+}
+
+// GetFooterStyle returns the current footer style.
+func (t *Table) GetFooterStyle() HeaderStyle {
+	return t.footerStyle
+}
+
+// SetBorderColor sets the style of the border characters.
+func (t *Table) SetBorderColor(style Style) {
+	t.borderColor = style
+}
+
+// GetBorderColor returns the style of the border characters.
+func (t *Table) GetBorderColor() Style {
+	return t.borderColor
+}
+
+// SetColorPolicy sets when styles are written.
+func (t *Table) SetColorPolicy(policy ColorPolicy) {
+	t.colorPolicy = policy
+	t.resolveColors()
+}
+
+// GetColorPolicy returns when styles are written.
+func (t *Table) GetColorPolicy() ColorPolicy {
+	return t.colorPolicy
+}
+
+// SetColorProfile sets the colors supported by the output.
+func (t *Table) SetColorProfile(profile ColorProfile) {
+	t.colorProfile = profile
+	t.resolveColors()
+}
+
+// GetColorProfile returns the colors supported by the output.
+func (t *Table) GetColorProfile() ColorProfile {
+	return t.colorProfile
+}
+
+// SetStripes sets the styles alternating over the data rows.
+func (t *Table) SetStripes(styles ...Style) {
+	t.stripes = styles
+}
+
+// GetStripes returns the styles alternating over the data rows.
+func (t *Table) GetStripes() []Style {
+	return t.stripes
+}
+
+// AddRule adds a rule styling the data cells by their content.
+func (t *Table) AddRule(rule Rule) {
+	t.rules = append(t.rules, rule)
+}
+
+// GetRules returns the rules styling the data cells.
+func (t *Table) GetRules() []Rule {
+	return t.rules
+}
+
 // SetHeaderStyleWithoutSeparator sets the header style and disables the header separator line.
 // This is a convenience method for the common use case of styled headers not needing separators.
 func (t *Table) SetHeaderStyleWithoutSeparator(style HeaderStyle) {
@@ -5,6 +5,8 @@ import (
 	"flag"
 	"os"
 	"path/filepath"
+	"slices"
+	"strconv"
 	"testing"
 )
 
@@ -76,6 +78,94 @@ func TestTableOutput(t *testing.T) {
 			name: "no_align_mode",
 			fn:   testNoAlignMode,
 		},
+		{
+			name: "wrap_columns",
+			fn:   testWrapColumns,
+		},
+		{
+			name: "multiline_cells",
+			fn:   testMultilineCells,
+		},
+		{
+			name: "max_table_width",
+			fn:   testMaxTableWidth,
+		},
+		{
+			name: "footer_rows",
+			fn:   testFooterRows,
+		},
+		{
+			name: "column_span",
+			fn:   testColumnSpan,
+		},
+		{
+			name: "row_span",
+			fn:   testRowSpan,
+		},
+		{
+			name: "header_groups",
+			fn:   testHeaderGroups,
+		},
+		{
+			name: "title_caption",
+			fn:   testTitleCaption,
+		},
+		{
+			name: "row_separators",
+			fn:   testRowSeparators,
+		},
+		{
+			name: "cell_styles",
+			fn:   testCellStyles,
+		},
+		{
+			name: "stripes",
+			fn:   testStripes,
+		},
+		{
+			name: "rules",
+			fn:   testRules,
+		},
+		{
+			name: "border_color",
+			fn:   testBorderColor,
+		},
+		{
+			name: "themes",
+			fn:   testThemes,
+		},
+		{
+			name: "hyperlinks",
+			fn:   testHyperlinks,
+		},
+		{
+			name: "truncation",
+			fn:   testTruncation,
+		},
+		{
+			name: "delimited",
+			fn:   testDelimited,
+		},
+		{
+			name: "html",
+			fn:   testHTML,
+		},
+		{
+			name: "json",
+			fn:   testJSON,
+		},
+		{
+			name: "yaml",
+			fn:   testYAML,
+		},
+		{
+			name: "latex",
+			fn:   testLaTeX,
+		},
+		{
+			name: "footer_without_rows",
+			fn:   testFooterWithoutRows,
+		},
 	}
 
 	for _, tt := range tests {
@@ -420,3 +510,572 @@ func testNoAlignMode() string {
 
 	return buf.String()
 }
+
+func testWrapColumns() string {
+	var buf bytes.Buffer
+	columns := []Column{
+		{Title: "ID", Width: 0, Align: Right},
+		{Title: "Truncate", Width: 0, MaxWidth: 10, Align: Left},
+		{Title: "Wrap", Width: 0, MaxWidth: 10, Align: Left, Overflow: OverflowWrap},
+		{Title: "Word", Width: 0, MaxWidth: 10, Align: Left, Overflow: OverflowWordWrap},
+	}
+
+	table := NewTable(&buf, columns)
+	table.AddRow("1", "short", "short", "short")
+	table.AddRow("2", "a long description", "a long description", "a long description")
+	table.AddRow("3", "\x1b[31mred colored text\x1b[0m", "\x1b[31mred colored text\x1b[0m", "\x1b[31mred colored text\x1b[0m")
+	table.AddRow("4", "日本語の長いテキスト", "日本語の長いテキスト", "日本語の長いテキスト")
+	table.Render()
+
+	return buf.String()
+}
+
+func testMultilineCells() string {
+	var buf bytes.Buffer
+	columns := []Column{
+		{Title: "Name", Width: 0, Align: Left},
+		{Title: "Address", Width: 0, Align: Left},
+		{Title: "Note", Width: 0, Align: Center},
+	}
+
+	// Widths are calculated in place, so give each table its own columns
+	table := NewTable(&buf, slices.Clone(columns))
+	table.AddRow("Alice", "1-2-3 Chiyoda\nTokyo\nJapan", "VIP")
+	table.AddRow("Bob", "Osaka", "line1\r\nline2")
+	table.Render()
+	buf.WriteString("\n")
+
+	markdown := NewTable(&buf, slices.Clone(columns), Border(MarkdownStyle))
+	markdown.AddRow("Alice", "1-2-3 Chiyoda\nTokyo", "VIP")
+	markdown.Render()
+
+	return buf.String()
+}
+
+func testMaxTableWidth() string {
+	var buf bytes.Buffer
+	columns := []Column{
+		{Title: "ID", Width: 0, Align: Right},
+		{Title: "Name", Width: 0, Align: Left},
+		{Title: "Description", Width: 0, Align: Left, Overflow: OverflowWordWrap},
+	}
+
+	table := NewTable(&buf, columns, MaxTableWidth(40))
+	table.AddRow("1", "Alexander Hamilton", "Founding father and the first Secretary of the Treasury")
+	table.AddRow("2", "Bob", "Short")
+	table.Render()
+
+	return buf.String()
+}
+
+func testFooterRows() string {
+	var buf bytes.Buffer
+	columns := []Column{
+		{Title: "Item", Width: 0, Align: Left},
+		{Title: "Qty", Width: 0, Align: Right},
+		{Title: "Price", Width: 0, Align: Right},
+	}
+
+	buf.WriteString("=== Buffered ===\n")
+	table := NewTable(&buf, slices.Clone(columns), Footer(BoldHeaderStyle()))
+	table.AddRow("Apple", "3", "$1.50")
+	table.AddRow("Banana", "12", "$3.00")
+	table.AddFooter("Total", "15", "$4.50")
+	table.AddFooterCells(Cell{Content: "Tax included", Align: Right})
+	table.Render()
+	buf.WriteString("\n")
+
+	buf.WriteString("=== Streaming ===\n")
+	fixed := []Column{
+		{Title: "Item", Width: 12, Align: Left},
+		{Title: "Qty", Width: 4, Align: Right},
+		{Title: "Price", Width: 6, Align: Right},
+	}
+	streaming := NewTable(&buf, fixed, Border(ASCIIStyle))
+	streaming.AddRow("Apple", "3", "$1.50")
+	streaming.AddRow("Banana", "12", "$3.00")
+	streaming.AddFooter("Total", "15", "$4.50")
+	streaming.Render()
+	buf.WriteString("\n")
+
+	buf.WriteString("=== Markdown ===\n")
+	markdown := NewTable(&buf, slices.Clone(columns), Border(MarkdownStyle))
+	markdown.AddRow("Apple", "3", "$1.50")
+	markdown.AddRow("Banana", "12", "$3.00")
+	markdown.AddFooter("Grand total", "15", "$4.50")
+	markdown.Render()
+
+	return buf.String()
+}
+
+func testColumnSpan() string {
+	var buf bytes.Buffer
+	columns := []Column{
+		{Title: "ID", Width: 0, Align: Right},
+		{Title: "Name", Width: 0, Align: Left},
+		{Title: "Q1", Width: 0, Align: Right},
+		{Title: "Q2", Width: 0, Align: Right},
+	}
+
+	table := NewTable(&buf, slices.Clone(columns))
+	table.AddRowCells(Cell{Content: "Sales department (this spans every column)", Span: 4, Align: Center})
+	table.AddRow("1", "Alice", "100", "120")
+	table.AddRowCells(Cell{Content: "2"}, Cell{Content: "Bob"}, Cell{Content: "on leave", Span: 2, Align: Center})
+	table.AddFooterCells(Cell{Content: "Total", Span: 2}, Cell{Content: "100"}, Cell{Content: "120"})
+	table.Render()
+	buf.WriteString("\n")
+
+	markdown := NewTable(&buf, slices.Clone(columns), Border(MarkdownStyle))
+	markdown.AddRowCells(Cell{Content: "Sales", Span: 2}, Cell{Content: "100"}, Cell{Content: "120"})
+	markdown.Render()
+
+	return buf.String()
+}
+
+func testRowSpan() string {
+	var buf bytes.Buffer
+	columns := []Column{
+		{Title: "Region", Width: 0, Align: Left},
+		{Title: "City", Width: 0, Align: Left},
+		{Title: "Sales", Width: 0, Align: Right},
+	}
+
+	table := NewTable(&buf, slices.Clone(columns))
+	table.AddRowCells(Cell{Content: "Kanto", RowSpan: 3, VAlign: AlignMiddle}, Cell{Content: "Tokyo"}, Cell{Content: "300"})
+	table.AddRow("Yokohama", "200")
+	table.AddRow("Chiba", "100")
+	table.AddRowCells(Cell{Content: "Kansai\n(West)", RowSpan: 2, VAlign: AlignBottom}, Cell{Content: "Osaka"}, Cell{Content: "250"})
+	table.AddRowCells(Cell{Content: "Kyoto"}, Cell{Content: "no data\nyet", RowSpan: 5})
+	table.AddRowCells(Cell{Content: "Hokkaido\nTohoku\nKyushu", RowSpan: 1}, Cell{Content: "-", Span: 2, Align: Center})
+	table.Render()
+	buf.WriteString("\n")
+
+	markdown := NewTable(&buf, slices.Clone(columns), Border(MarkdownStyle))
+	markdown.AddRowCells(Cell{Content: "Kanto", RowSpan: 2}, Cell{Content: "Tokyo"}, Cell{Content: "300"})
+	markdown.AddRow("Yokohama", "200")
+	markdown.Render()
+
+	return buf.String()
+}
+
+func testHeaderGroups() string {
+	var buf bytes.Buffer
+	columns := []Column{
+		{Title: "Name", Width: 0, Align: Left},
+		{Title: "Jan", Width: 0, Align: Right},
+		{Title: "Feb", Width: 0, Align: Right},
+		{Title: "Mar", Width: 0, Align: Right},
+		{Title: "Apr", Width: 0, Align: Right},
+		{Title: "Total", Width: 0, Align: Right},
+	}
+	levels := []TableOption{
+		HeaderGroups(ColumnGroup{}, ColumnGroup{Title: "First half of the fiscal year", Span: 4}),
+		HeaderGroups(ColumnGroup{}, ColumnGroup{Title: "Q1", Span: 3}, ColumnGroup{Title: "Q2"}),
+	}
+
+	table := NewTable(&buf, slices.Clone(columns), levels...)
+	table.AddRow("Alice", "10", "20", "30", "40", "100")
+	table.AddRow("Bob", "5", "5", "5", "5", "20")
+	table.Render()
+	buf.WriteString("\n")
+
+	ascii := NewTable(&buf, slices.Clone(columns), append(levels, Border(ASCIIStyle), Header(BoldHeaderStyle()))...)
+	ascii.AddRow("Alice", "10", "20", "30", "40", "100")
+	ascii.Render()
+	buf.WriteString("\n")
+
+	markdown := NewTable(&buf, slices.Clone(columns), append(levels, Border(MarkdownStyle))...)
+	markdown.AddRow("Alice", "10", "20", "30", "40", "100")
+	markdown.Render()
+
+	return buf.String()
+}
+
+func testTitleCaption() string {
+	var buf bytes.Buffer
+	columns := []Column{
+		{Title: "Name", Width: 0, Align: Left},
+		{Title: "Score", Width: 0, Align: Right},
+	}
+
+	table := NewTable(&buf, slices.Clone(columns), Title("Final Exam Results"), Caption("Table 1: scores out of 100"))
+	table.AddRow("Alice", "85")
+	table.AddRow("Bob", "92")
+	table.Render()
+	buf.WriteString("\n")
+
+	fixed := []Column{
+		{Title: "Name", Width: 8, Align: Left},
+		{Title: "Score", Width: 5, Align: Right},
+	}
+	streaming := NewTable(&buf, fixed, Border(DoubleStyle), Title("Scores"), TitleAlign(Left), Caption("As of today"), CaptionAlign(Right))
+	streaming.AddRow("Alice", "85")
+	streaming.Render()
+	buf.WriteString("\n")
+
+	markdown := NewTable(&buf, slices.Clone(columns), Border(MarkdownStyle), Title("Final Exam Results"), Caption("Table 1: scores out of 100"))
+	markdown.AddRow("Alice", "85")
+	markdown.Render()
+
+	return buf.String()
+}
+
+func testRowSeparators() string {
+	var buf bytes.Buffer
+	columns := []Column{
+		{Title: "Region", Width: 0, Align: Left},
+		{Title: "City", Width: 0, Align: Left},
+		{Title: "Sales", Width: 0, Align: Right},
+	}
+
+	buf.WriteString("=== Every row ===\n")
+	config := GetBorderConfig(BoxDrawingStyle)
+	config.RowSeparator = true
+	table := NewTable(&buf, slices.Clone(columns), BorderConfig(config))
+	table.AddRowCells(Cell{Content: "Kanto", RowSpan: 3, VAlign: AlignMiddle}, Cell{Content: "Tokyo"}, Cell{Content: "300"})
+	table.AddRow("Yokohama", "200")
+	table.AddRow("Chiba", "100")
+	table.AddRowCells(Cell{Content: "Kansai", RowSpan: 2}, Cell{Content: "Osaka"}, Cell{Content: "250"})
+	table.AddRowCells(Cell{Content: "Kyoto"}, Cell{Content: "150"})
+	table.AddFooter("Total", "", "1000")
+	table.Render()
+	buf.WriteString("\n")
+
+	buf.WriteString("=== Groups (streaming) ===\n")
+	fixed := []Column{
+		{Title: "Region", Width: 6, Align: Left},
+		{Title: "City", Width: 8, Align: Left},
+		{Title: "Sales", Width: 5, Align: Right},
+	}
+	streaming := NewTable(&buf, fixed, Border(ASCIIStyle))
+	streaming.AddSeparator() // No effect before the first row
+	streaming.AddRow("Kanto", "Tokyo", "300")
+	streaming.AddRow("Kanto", "Yokohama", "200")
+	streaming.AddSeparator()
+	streaming.AddRow("Kansai", "Osaka", "250")
+	streaming.AddSeparator() // No effect after the last row
+	streaming.Render()
+
+	return buf.String()
+}
+
+func testCellStyles() string {
+	var buf bytes.Buffer
+	columns := []Column{
+		{Title: "Product", Width: 0, Align: Left},
+		{Title: "Price", Width: 0, Align: Right, Style: Style{ForegroundColor: AnsiCyan}},
+		{Title: "Status", Width: 0, Align: Center},
+	}
+
+	table := NewTable(&buf, columns, Header(BoldHeaderStyle()))
+	table.AddRow("Laptop", "$999.99", "Available")
+	table.AddRowCells(
+		Cell{Content: "Mouse"},
+		Cell{Content: "$29.99"},
+		Cell{Content: "Sold Out", Style: Style{Bold: true, ForegroundColor: AnsiRed}},
+	)
+	table.AddStyledRow(Style{BackgroundColor: AnsiBgBlue},
+		Cell{Content: "Keyboard\nwireless"},
+		Cell{Content: "$" + AnsiYellow + "79.99" + AnsiReset},
+		Cell{Content: "Available", Style: Style{BackgroundColor: AnsiBgGreen}},
+	)
+	table.Render()
+
+	return buf.String()
+}
+
+func testStripes() string {
+	var buf bytes.Buffer
+	columns := []Column{
+		{Title: "Name", Width: 0, Align: Left},
+		{Title: "Address", Width: 0, Align: Left},
+	}
+	stripes := []Style{{}, {BackgroundColor: AnsiBgBlue}}
+
+	buf.WriteString("=== Buffered ===\n")
+	table := NewTable(&buf, slices.Clone(columns), Stripes(stripes...))
+	table.AddRow("Alice", "Tokyo")
+	table.AddRow("Bob", "1-2-3 Chiyoda\nTokyo")
+	table.AddRow("Carol", "Kyoto")
+	table.AddRowCells(Cell{Content: "Dave"}, Cell{Content: "Osaka", Style: Style{ForegroundColor: AnsiRed}})
+	table.Render()
+	buf.WriteString("\n")
+
+	buf.WriteString("=== Streaming ===\n")
+	fixed := []Column{
+		{Title: "Name", Width: 5, Align: Left},
+		{Title: "Address", Width: 8, Align: Left},
+	}
+	streaming := NewTable(&buf, fixed, Stripes(Style{BackgroundColor: AnsiBgBlue}, Style{BackgroundColor: AnsiBgGreen}, Style{}))
+	streaming.AddRow("Alice", "Tokyo")
+	streaming.AddRow("Bob", "Nagoya")
+	streaming.AddRow("Carol", "Osaka")
+	streaming.AddRow("Dave", "Kyoto")
+	streaming.Render()
+
+	return buf.String()
+}
+
+func testRules() string {
+	var buf bytes.Buffer
+	columns := []Column{
+		{Title: "Test", Width: 0, Align: Left},
+		{Title: "Status", Width: 0, Align: Center},
+		{Title: "Delta", Width: 0, Align: Right},
+	}
+	negative := func(_ int, _, content string) bool {
+		v, err := strconv.ParseFloat(content, 64)
+		return err == nil && v < 0
+	}
+	rules := []Rule{
+		{Condition: ColumnEquals("Status", "FAIL"), Style: Style{Bold: true, ForegroundColor: AnsiRed}},
+		{Condition: negative, Style: Style{ForegroundColor: AnsiYellow}},
+	}
+
+	for _, style := range []BorderStyle{BoxDrawingStyle, TSVStyle} {
+		buf.WriteString("=== " + string(style) + " ===\n")
+		table := NewTable(&buf, slices.Clone(columns), Border(style), Rules(rules...))
+		table.AddRow("parse", "PASS", "1.5")
+		table.AddRow("render", "FAIL", "-0.25")
+		table.AddRow("FAIL", "PASS", "0")
+		table.Render()
+		buf.WriteString("\n")
+	}
+
+	return buf.String()
+}
+
+func testBorderColor() string {
+	var buf bytes.Buffer
+	columns := []Column{
+		{Title: "Product", Width: 0, Align: Left},
+		{Title: "Status", Width: 0, Align: Center, Style: Style{ForegroundColor: AnsiGreen}},
+	}
+
+	table := NewTable(&buf, columns,
+		Title("Inventory"),
+		Header(Style{Bold: true, BackgroundColor: AnsiBgBlue}),
+		BorderColor(Style{Dim: true, ForegroundColor: AnsiBrightBlack}),
+	)
+	table.AddRow("Laptop", "Available")
+	table.AddSeparator()
+	table.AddRow("Mouse", "Sold Out")
+	table.Render()
+
+	return buf.String()
+}
+
+func testThemes() string {
+	var buf bytes.Buffer
+	columns := []Column{
+		{Title: "Item", Width: 0, Align: Left},
+		{Title: "Qty", Width: 0, Align: Right},
+	}
+
+	for _, name := range ThemeNames() {
+		theme, _ := GetTheme(name)
+		buf.WriteString("=== " + name + " ===\n")
+		table := NewTable(&buf, slices.Clone(columns), WithTheme(theme))
+		table.AddRow("Apple", "3")
+		table.AddRow("Banana", "12")
+		table.AddFooter("Total", "15")
+		table.Render()
+		buf.WriteString("\n")
+	}
+
+	return buf.String()
+}
+
+func testHyperlinks() string {
+	var buf bytes.Buffer
+	columns := []Column{
+		{Title: "Project", Width: 8, Align: Left, Overflow: OverflowWordWrap},
+		{Title: "License", Width: 0, Align: Center},
+	}
+
+	addRows := func(table *Table) {
+		table.AddRowCells(Cell{Content: "termhyo", Link: "https://github.com/noborus/termhyo"}, Cell{Content: "MIT"})
+		table.AddRowCells(Cell{Content: "ov pager", Link: "https://github.com/noborus/ov"}, Cell{Content: "MIT"})
+	}
+
+	table := NewTable(&buf, slices.Clone(columns))
+	addRows(table)
+	table.AddRowCells(Cell{Content: "text \x1b]8;;https://example.com\x1b\\with link\x1b]8;;\x1b\\"}, Cell{Content: "-"})
+	table.Render()
+
+	buf.WriteString("\n")
+	columns[0].Width = 0
+	table = NewTable(&buf, slices.Clone(columns), Border(MarkdownStyle))
+	addRows(table)
+	table.Render()
+
+	return buf.String()
+}
+
+func testTruncation() string {
+	var buf bytes.Buffer
+	columns := []Column{
+		{Title: "End", Width: 10, Align: Left},
+		{Title: "Middle", Width: 10, Align: Left, Overflow: OverflowTruncateMiddle},
+		{Title: "Start", Width: 10, Align: Left, Overflow: OverflowTruncateStart},
+	}
+
+	for _, ellipsis := range []string{"...", "…", ""} {
+		buf.WriteString("=== " + strconv.Quote(ellipsis) + " ===\n")
+		table := NewTable(&buf, slices.Clone(columns), Ellipsis(ellipsis))
+		table.AddRow("/usr/local/share/doc", "/usr/local/share/doc", "/usr/local/share/doc")
+		table.AddRow("\x1b[31mvery long red text\x1b[0m", "\x1b[31mvery long red text\x1b[0m", "\x1b[31mvery long red text\x1b[0m")
+		table.Render()
+	}
+
+	return buf.String()
+}
+
+func testDelimited() string {
+	var buf bytes.Buffer
+	columns := []Column{
+		{Title: "Name", Width: 0, Align: Left},
+		{Title: "Jan", Width: 0, Align: Right},
+		{Title: "Note", Width: 0, Align: Left},
+	}
+
+	for _, style := range []BorderStyle{CSVStyle, TSVStyle} {
+		buf.WriteString("=== " + string(style) + " ===\n")
+		table := NewTable(&buf, slices.Clone(columns), Border(style),
+			HeaderGroups(ColumnGroup{}, ColumnGroup{Title: "Q1", Span: 2}),
+			Header(Style{Bold: true}),
+		)
+		table.AddRow("Smith, John", "12", "said \"hello\"")
+		table.AddRow("\x1b[31mRed\x1b[0m", "3", "line 1\nline 2")
+		table.AddRow("tab", "4", "a\tb \\ c")
+		table.AddRowCells(Cell{Content: "spanned", Span: 2}, Cell{Content: ""})
+		table.AddFooter("Total", "19", "")
+		table.Render()
+	}
+
+	return buf.String()
+}
+
+func testHTML() string {
+	var buf bytes.Buffer
+	columns := []Column{
+		{Title: "Name", Width: 0, Align: Left},
+		{Title: "Jan", Width: 0, Align: Right},
+		{Title: "Feb", Width: 0, Align: Right, Style: Style{Foreground: ColorBlue}},
+	}
+
+	table := NewTable(&buf, columns, Border(HTMLStyle),
+		Title("Sales <2024>"),
+		Caption("Figures & totals"),
+		HeaderGroups(ColumnGroup{}, ColumnGroup{Title: "Q1", Span: 2}),
+		Header(Style{Bold: true, ForegroundColor: AnsiWhite, BackgroundColor: AnsiBgBlue}),
+	)
+	table.AddRow("Alice & Bob", "\x1b[1;31m-12\x1b[0m", "\x1b[38;5;208m7\x1b[0m")
+	table.AddRowCells(Cell{Content: "Carol", Link: "https://example.com/?a=1&b=2"}, Cell{Content: "n/a", Span: 2, Align: Center})
+	table.AddRowCells(Cell{Content: "Dave\nEve", RowSpan: 2, VAlign: AlignMiddle}, Cell{Content: "3"}, Cell{Content: "4"})
+	table.AddRow("5", "6")
+	table.AddFooter("Total", "\x1b[38;2;0;128;0m2\x1b[0m", "17")
+	table.Render()
+
+	return buf.String()
+}
+
+func testJSON() string {
+	var buf bytes.Buffer
+	columns := []Column{
+		{Title: "Name", Width: 0, Align: Left},
+		{Title: "Age", Width: 0, Align: Right, Type: TypeNumber},
+		{Title: "Active", Width: 0, Align: Center, Type: TypeBool},
+		{Title: "Note", Width: 0, Align: Left},
+	}
+
+	for _, style := range []BorderStyle{JSONStyle, JSONLinesStyle} {
+		buf.WriteString("=== " + string(style) + " ===\n")
+		table := NewTable(&buf, slices.Clone(columns), Border(style))
+		table.AddRow("Alice", "25", "true", "says \"hi\" <b>")
+		table.AddRow("\x1b[31mBob\x1b[0m", "n/a", "", "line 1\nline 2")
+		table.AddRowCells(Cell{Content: "Carol"}, Cell{Content: " 3.5e2 "}, Cell{Content: "FALSE", Span: 2})
+		table.AddFooter("Total", "", "", "")
+		table.Render()
+	}
+
+	return buf.String()
+}
+
+func testYAML() string {
+	var buf bytes.Buffer
+	columns := []Column{
+		{Title: "Name", Width: 0, Align: Left},
+		{Title: "Age", Width: 0, Align: Right, Type: TypeNumber},
+		{Title: "Note", Width: 0, Align: Left},
+		{Title: "key: value", Width: 0, Align: Left},
+	}
+
+	table := NewTable(&buf, columns, Border(YAMLStyle))
+	table.AddRow("Alice", "25", "true", "yes")
+	table.AddRow("\x1b[31mBob\x1b[0m", "n/a", "line 1\nline 2", "2024-01-01")
+	table.AddRow("it's", "", "  indented\nblock\n", "- item")
+	table.AddRow("", "0x1F", "tab\there", "null")
+	table.AddRowCells(Cell{Content: "# comment"}, Cell{Content: "1e3"}, Cell{Content: "a: b", Span: 2})
+	table.AddFooter("Total", "25", "", "")
+	table.Render()
+
+	buf.WriteString("=== empty ===\n")
+	table = NewTable(&buf, slices.Clone(columns), Border(YAMLStyle))
+	table.Render()
+
+	return buf.String()
+}
+
+func testLaTeX() string {
+	var buf bytes.Buffer
+	columns := []Column{
+		{Title: "Benchmark", Width: 0, Align: Left},
+		{Title: "ns/op", Width: 0, Align: Right},
+		{Title: "B/op", Width: 0, Align: Right},
+		{Title: "Note", Width: 0, Align: Center},
+	}
+
+	table := NewTable(&buf, slices.Clone(columns), Border(LaTeXStyle),
+		HeaderGroups(ColumnGroup{}, ColumnGroup{Title: "Result", Span: 2}),
+	)
+	table.AddRow("Parse_JSON", "1,024", "512", "50% & $5 #1")
+	table.AddRow("\x1b[1mRender\x1b[0m {a~b^c}", "2048", "0", `C:\tmp`)
+	table.AddRowCells(Cell{Content: "Wrap\nlines", RowSpan: 2}, Cell{Content: "n/a", Span: 2, Align: Center}, Cell{Content: "-"})
+	table.AddRow("128", "64", "ok")
+	table.AddFooter("Total", "3200", "576", "")
+	table.Render()
+
+	buf.WriteString("\n")
+	config := GetBorderConfig(LaTeXStyle)
+	config.Middle = false
+	config.RowSeparator = true
+	config.Left, config.Right = true, true
+	table = NewTable(&buf, slices.Clone(columns), Border(LaTeXStyle), BorderConfig(config))
+	table.AddRow("a", "1", "2", "x")
+	table.AddRow("b", "3", "4", "y")
+	table.Render()
+
+	return buf.String()
+}
+
+func testFooterWithoutRows() string {
+	var buf bytes.Buffer
+	columns := []Column{
+		{Title: "Item", Width: 0, Align: Left},
+		{Title: "Total", Width: 0, Align: Right},
+	}
+
+	// Buffered mode
+	table := NewTable(&buf, slices.Clone(columns))
+	table.AddFooter("Sum", "0")
+	table.Render()
+
+	// Streaming mode
+	columns[0].Width, columns[1].Width = 6, 6
+	table = NewTable(&buf, slices.Clone(columns))
+	table.AddFooter("Sum", "0")
+	table.Render()
+
+	return buf.String()
+}
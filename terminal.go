@@ -0,0 +1,34 @@
+package termhyo
+
+import (
+	"io"
+	"os"
+)
+
+// TerminalWidth returns the width of the terminal connected to f.
+// It returns 0 if f is not a terminal, which MaxTableWidth treats as no limit.
+//
+// Example:
+//
+//	table := termhyo.NewTable(os.Stdout, columns, termhyo.MaxTableWidth(termhyo.TerminalWidth(os.Stdout)))
+func TerminalWidth(f *os.File) int {
+	if f == nil {
+		return 0
+	}
+	width, ok := terminalWidth(f.Fd())
+	if !ok || width <= 0 {
+		return 0
+	}
+	return width
+}
+
+// isTerminal reports whether the writer is a terminal.
+// A terminal that reports 0 columns is still a terminal.
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(interface{ Fd() uintptr })
+	if !ok {
+		return false
+	}
+	_, ok = terminalWidth(f.Fd())
+	return ok
+}
@@ -0,0 +1,24 @@
+//go:build linux
+
+package termhyo
+
+import (
+	"os"
+	"testing"
+)
+
+// TestZeroColumnTerminal tests that a new pty, which reports 0 columns, is still a terminal.
+func TestZeroColumnTerminal(t *testing.T) {
+	f, err := os.OpenFile("/dev/ptmx", os.O_RDWR, 0)
+	if err != nil {
+		t.Skipf("no pty available: %v", err)
+	}
+	defer f.Close()
+
+	if !isTerminal(f) {
+		t.Error("isTerminal() = false for a pty")
+	}
+	if width := TerminalWidth(f); width != 0 {
+		t.Errorf("TerminalWidth() = %d for a new pty, expected 0", width)
+	}
+}
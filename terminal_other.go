@@ -0,0 +1,8 @@
+//go:build !linux && !darwin && !dragonfly && !freebsd && !netbsd && !openbsd && !windows
+
+package termhyo
+
+// terminalWidth reports that terminal detection is not supported on this platform.
+func terminalWidth(_ uintptr) (int, bool) {
+	return 0, false
+}
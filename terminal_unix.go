@@ -0,0 +1,28 @@
+//go:build linux || darwin || dragonfly || freebsd || netbsd || openbsd
+
+package termhyo
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+// winsize is the terminal window size returned by the TIOCGWINSZ ioctl.
+type winsize struct {
+	Row    uint16
+	Col    uint16
+	Xpixel uint16
+	Ypixel uint16
+}
+
+// terminalWidth returns the number of columns of the terminal referred to by fd
+// and whether fd is a terminal. Some terminals, such as serial consoles and new ptys,
+// report 0 columns.
+func terminalWidth(fd uintptr) (int, bool) {
+	var ws winsize
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, fd, uintptr(syscall.TIOCGWINSZ), uintptr(unsafe.Pointer(&ws)))
+	if errno != 0 {
+		return 0, false
+	}
+	return int(ws.Col), true
+}
@@ -0,0 +1,41 @@
+//go:build windows
+
+package termhyo
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+var procGetConsoleScreenBufferInfo = syscall.NewLazyDLL("kernel32.dll").NewProc("GetConsoleScreenBufferInfo")
+
+type coord struct {
+	X int16
+	Y int16
+}
+
+type smallRect struct {
+	Left   int16
+	Top    int16
+	Right  int16
+	Bottom int16
+}
+
+// consoleScreenBufferInfo is the CONSOLE_SCREEN_BUFFER_INFO structure.
+type consoleScreenBufferInfo struct {
+	Size              coord
+	CursorPosition    coord
+	Attributes        uint16
+	Window            smallRect
+	MaximumWindowSize coord
+}
+
+// terminalWidth returns the number of columns of the console window referred to by fd.
+func terminalWidth(fd uintptr) (int, bool) {
+	var info consoleScreenBufferInfo
+	r, _, _ := procGetConsoleScreenBufferInfo.Call(fd, uintptr(unsafe.Pointer(&info)))
+	if r == 0 {
+		return 0, false
+	}
+	return int(info.Window.Right-info.Window.Left) + 1, true
+}
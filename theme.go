@@ -0,0 +1,106 @@
+package termhyo
+
+import (
+	"maps"
+	"slices"
+	"sync"
+)
+
+// Theme bundles the border configuration and styles of a table,
+// so that tables are rendered consistently.
+type Theme struct {
+	Border      TableBorderConfig // Border characters and flags (nil Chars = keep the table border)
+	Header      Style             // Style of the header rows
+	Footer      Style             // Style of the footer rows
+	BorderColor Style             // Style of the border characters
+	Stripes     []Style           // Styles alternating over the data rows
+}
+
+// Built-in themes.
+var (
+	plainTheme = Theme{
+		Border: boxDrawingConfig,
+	}
+
+	darkTheme = Theme{
+		Border:      roundedConfig,
+		Header:      Style{Bold: true, Foreground: ColorBrightWhite, Background: PaletteColor(238)},
+		Footer:      Style{Bold: true},
+		BorderColor: Style{Foreground: PaletteColor(244)},
+		Stripes:     []Style{{}, {Background: PaletteColor(235)}},
+	}
+
+	lightTheme = Theme{
+		Border:      boxDrawingConfig,
+		Header:      Style{Bold: true, Foreground: ColorBlack, Background: PaletteColor(252)},
+		Footer:      Style{Bold: true},
+		BorderColor: Style{Foreground: PaletteColor(245)},
+		Stripes:     []Style{{}, {Background: PaletteColor(255)}},
+	}
+
+	solarizedTheme = Theme{
+		Border:      boxDrawingConfig,
+		Header:      Style{Bold: true, Foreground: RGBColor(38, 139, 210), Background: RGBColor(7, 54, 66)},
+		Footer:      Style{Bold: true, Foreground: RGBColor(181, 137, 0)},
+		BorderColor: Style{Foreground: RGBColor(88, 110, 117)},
+		Stripes:     []Style{{Background: RGBColor(0, 43, 54)}, {Background: RGBColor(7, 54, 66)}},
+	}
+)
+
+var (
+	themesMu sync.RWMutex
+	themes   = map[string]Theme{
+		"plain":     plainTheme,
+		"dark":      darkTheme,
+		"light":     lightTheme,
+		"solarized": solarizedTheme,
+	}
+)
+
+// RegisterTheme adds a theme to the registry, replacing any theme with the same name.
+func RegisterTheme(name string, theme Theme) {
+	themesMu.Lock()
+	defer themesMu.Unlock()
+	themes[name] = theme.clone()
+}
+
+// GetTheme returns the registered theme with the given name.
+// The built-in themes are "plain", "dark", "light" and "solarized".
+func GetTheme(name string) (Theme, bool) {
+	themesMu.RLock()
+	defer themesMu.RUnlock()
+	theme, ok := themes[name]
+	return theme.clone(), ok
+}
+
+// ThemeNames returns the names of the registered themes in sorted order.
+func ThemeNames() []string {
+	themesMu.RLock()
+	defer themesMu.RUnlock()
+	return slices.Sorted(maps.Keys(themes))
+}
+
+// clone returns a copy of the theme that does not share the border characters and stripes.
+func (theme Theme) clone() Theme {
+	theme.Border.Chars = maps.Clone(theme.Border.Chars)
+	theme.Stripes = slices.Clone(theme.Stripes)
+	return theme
+}
+
+// WithTheme applies a theme to the table (option).
+// Options after it can override parts of the theme:
+//
+//	theme, _ := termhyo.GetTheme("dark")
+//	table := termhyo.NewTable(os.Stdout, columns, termhyo.WithTheme(theme))
+func WithTheme(theme Theme) TableOption {
+	return func(t *Table) {
+		if theme.Border.Chars != nil {
+			t.borderConfig = theme.Border
+			t.borders = theme.Border.Chars
+		}
+		t.headerStyle = theme.Header
+		t.footerStyle = theme.Footer
+		t.borderColor = theme.BorderColor
+		t.stripes = theme.Stripes
+	}
+}
@@ -0,0 +1,314 @@
+package termhyo
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrUnknownKey is returned when a theme file contains a key that is not recognized.
+	ErrUnknownKey = errors.New("unknown key")
+	// ErrInvalidValue is returned when a theme file contains a value of the wrong type or range.
+	ErrInvalidValue = errors.New("invalid value")
+	// ErrUnknownFormat is returned when the format of a theme file cannot be determined from its extension.
+	ErrUnknownFormat = errors.New("unknown theme file format")
+)
+
+// ThemeError reports an invalid key or value in a theme file.
+type ThemeError struct {
+	Key string // Path of the offending key, such as "border.chars.cross"
+	Err error  // ErrUnknownKey or ErrInvalidValue, possibly wrapped with details
+}
+
+// Error returns the error message with the offending key.
+func (e *ThemeError) Error() string {
+	return "theme: " + e.Key + ": " + e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *ThemeError) Unwrap() error {
+	return e.Err
+}
+
+// themeErrorf returns a ThemeError for an invalid value.
+func themeErrorf(key, format string, args ...any) error {
+	return &ThemeError{Key: key, Err: fmt.Errorf("%w: "+format, append([]any{ErrInvalidValue}, args...)...)}
+}
+
+// borderKeys are the keys of TableBorderConfig.Chars.
+var borderKeys = []string{
+	"horizontal", "vertical", "cross",
+	"top_left", "top_right", "bottom_left", "bottom_right",
+	"top_cross", "bottom_cross", "left_cross", "right_cross",
+}
+
+// LoadTheme reads a theme from a JSON (.json) or YAML (.yaml, .yml) file.
+//
+// A theme file looks like this in YAML:
+//
+//	border:
+//	  style: rounded        # built-in border style to start from
+//	  chars:
+//	    vertical: "┃"
+//	  row_separator: true
+//	header:
+//	  bold: true
+//	  foreground: white     # color name, "#rrggbb" or 256-color index
+//	  background: "#005f87"
+//	border_color:
+//	  foreground: 244
+//	stripes:
+//	  - {}
+//	  - background: 236
+//
+// The footer key takes the same attributes as the header.
+func LoadTheme(path string) (Theme, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Theme{}, err
+	}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return ParseThemeJSON(data)
+	case ".yaml", ".yml":
+		return ParseThemeYAML(data)
+	default:
+		return Theme{}, fmt.Errorf("%w: %s", ErrUnknownFormat, path)
+	}
+}
+
+// ParseThemeJSON parses a theme in JSON format. See LoadTheme for the keys.
+func ParseThemeJSON(data []byte) (Theme, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return Theme{}, fmt.Errorf("theme: %w", err)
+	}
+	return themeFromValue(value)
+}
+
+// ParseThemeYAML parses a theme in YAML format. See LoadTheme for the keys.
+// Only the block and flow collections and scalars of YAML are supported.
+func ParseThemeYAML(data []byte) (Theme, error) {
+	value, err := parseYAML(data)
+	if err != nil {
+		return Theme{}, fmt.Errorf("theme: %w", err)
+	}
+	return themeFromValue(value)
+}
+
+// themeFromValue converts a decoded theme file into a Theme.
+func themeFromValue(value any) (Theme, error) {
+	var theme Theme
+	if value == nil {
+		return theme, nil
+	}
+	root, ok := value.(map[string]any)
+	if !ok {
+		return theme, themeErrorf("(root)", "expected a mapping")
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(root)) {
+		var err error
+		switch key {
+		case "border":
+			theme.Border, err = borderFromValue(key, root[key])
+		case "header":
+			theme.Header, err = styleFromValue(key, root[key])
+		case "footer":
+			theme.Footer, err = styleFromValue(key, root[key])
+		case "border_color":
+			theme.BorderColor, err = styleFromValue(key, root[key])
+		case "stripes":
+			theme.Stripes, err = stripesFromValue(key, root[key])
+		default:
+			err = &ThemeError{Key: key, Err: ErrUnknownKey}
+		}
+		if err != nil {
+			return Theme{}, err
+		}
+	}
+	return theme, nil
+}
+
+// borderFromValue converts the border section of a theme file.
+// The configuration starts from the built-in border style given by "style", or BoxDrawingStyle.
+func borderFromValue(path string, value any) (TableBorderConfig, error) {
+	section, ok := value.(map[string]any)
+	if !ok {
+		return TableBorderConfig{}, themeErrorf(path, "expected a mapping")
+	}
+
+	style := BoxDrawingStyle
+	if v, ok := section["style"]; ok {
+		name, ok := v.(string)
+		if ok && slices.Contains(dataFormatStyles, BorderStyle(name)) {
+			return TableBorderConfig{}, themeErrorf(path+".style", "%s is a data format, choose it with Border() instead of a theme", name)
+		}
+		if !ok || !slices.Contains(borderStyles, BorderStyle(name)) {
+			return TableBorderConfig{}, themeErrorf(path+".style", "unknown border style %v", v)
+		}
+		style = BorderStyle(name)
+	}
+	config := GetBorderConfig(style)
+	config.Chars = maps.Clone(config.Chars)
+
+	flags := map[string]*bool{
+		"top":           &config.Top,
+		"bottom":        &config.Bottom,
+		"middle":        &config.Middle,
+		"left":          &config.Left,
+		"right":         &config.Right,
+		"vertical":      &config.Vertical,
+		"padding":       &config.Padding,
+		"row_separator": &config.RowSeparator,
+	}
+	for _, key := range slices.Sorted(maps.Keys(section)) {
+		keyPath := path + "." + key
+		switch {
+		case key == "style":
+		case key == "chars":
+			if err := borderCharsFromValue(keyPath, section[key], config.Chars); err != nil {
+				return TableBorderConfig{}, err
+			}
+		case flags[key] != nil:
+			b, ok := section[key].(bool)
+			if !ok {
+				return TableBorderConfig{}, themeErrorf(keyPath, "expected a boolean, got %v", section[key])
+			}
+			*flags[key] = b
+		default:
+			return TableBorderConfig{}, &ThemeError{Key: keyPath, Err: ErrUnknownKey}
+		}
+	}
+	return config, nil
+}
+
+// borderStyles are the built-in border styles that can be named in a theme file.
+var borderStyles = []BorderStyle{
+	BoxDrawingStyle, ASCIIStyle, RoundedStyle, DoubleStyle,
+	MinimalStyle, VerticalBarStyle, MarkdownStyle, TSVStyle,
+}
+
+// dataFormatStyles are the border styles with their own renderers.
+// A theme only sets the border configuration, so they are chosen with Border instead.
+var dataFormatStyles = []BorderStyle{CSVStyle, HTMLStyle, JSONStyle, JSONLinesStyle, YAMLStyle, LaTeXStyle}
+
+// borderCharsFromValue sets the border characters given in a theme file.
+// Each character must be at most one column wide, or the borders would not line up with the cells.
+func borderCharsFromValue(path string, value any, chars map[string]string) error {
+	section, ok := value.(map[string]any)
+	if !ok {
+		return themeErrorf(path, "expected a mapping")
+	}
+	for _, key := range slices.Sorted(maps.Keys(section)) {
+		keyPath := path + "." + key
+		if !slices.Contains(borderKeys, key) {
+			return &ThemeError{Key: keyPath, Err: ErrUnknownKey}
+		}
+		s, ok := section[key].(string)
+		if !ok {
+			return themeErrorf(keyPath, "expected a string, got %v", section[key])
+		}
+		if width := StringWidth(s); width > 1 {
+			return themeErrorf(keyPath, "border character %q is %d columns wide, expected at most 1", s, width)
+		}
+		chars[key] = s
+	}
+	return nil
+}
+
+// styleFromValue converts a style section of a theme file.
+func styleFromValue(path string, value any) (Style, error) {
+	var style Style
+	if value == nil {
+		return style, nil
+	}
+	section, ok := value.(map[string]any)
+	if !ok {
+		return style, themeErrorf(path, "expected a mapping")
+	}
+
+	attributes := map[string]*bool{
+		"bold":      &style.Bold,
+		"underline": &style.Underline,
+		"italic":    &style.Italic,
+		"dim":       &style.Dim,
+		"blink":     &style.Blink,
+		"reverse":   &style.Reverse,
+		"strike":    &style.Strike,
+	}
+	for _, key := range slices.Sorted(maps.Keys(section)) {
+		keyPath := path + "." + key
+		var err error
+		switch {
+		case key == "foreground":
+			style.Foreground, err = colorFromValue(keyPath, section[key])
+		case key == "background":
+			style.Background, err = colorFromValue(keyPath, section[key])
+		case attributes[key] != nil:
+			b, ok := section[key].(bool)
+			if !ok {
+				err = themeErrorf(keyPath, "expected a boolean, got %v", section[key])
+			}
+			*attributes[key] = b
+		default:
+			err = &ThemeError{Key: keyPath, Err: ErrUnknownKey}
+		}
+		if err != nil {
+			return Style{}, err
+		}
+	}
+	return style, nil
+}
+
+// stripesFromValue converts the stripes section of a theme file.
+func stripesFromValue(path string, value any) ([]Style, error) {
+	items, ok := value.([]any)
+	if !ok {
+		return nil, themeErrorf(path, "expected a sequence")
+	}
+	stripes := make([]Style, len(items))
+	for i, item := range items {
+		style, err := styleFromValue(path+"["+strconv.Itoa(i)+"]", item)
+		if err != nil {
+			return nil, err
+		}
+		stripes[i] = style
+	}
+	return stripes, nil
+}
+
+// colorFromValue converts a color of a theme file.
+// A color is a name such as "red" or "bright_blue", "#rrggbb", or a 256-color index.
+func colorFromValue(path string, value any) (Color, error) {
+	var s string
+	switch v := value.(type) {
+	case nil:
+		return ColorDefault, nil
+	case json.Number:
+		s = v.String()
+	case string:
+		if strings.HasPrefix(v, "\x1b") {
+			return ColorDefault, themeErrorf(path, "expected a color name, got an escape sequence")
+		}
+		s = v
+	default:
+		return ColorDefault, themeErrorf(path, "expected a color, got %v", value)
+	}
+
+	c, err := ParseColor(s)
+	if err != nil {
+		return ColorDefault, themeErrorf(path, "%v", err)
+	}
+	return c, nil
+}
@@ -0,0 +1,135 @@
+package termhyo
+
+import (
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// TestLoadTheme tests loading the same theme from JSON and YAML files.
+func TestLoadTheme(t *testing.T) {
+	border := GetBorderConfig(RoundedStyle)
+	border.Chars = map[string]string{
+		"horizontal":   "━",
+		"vertical":     "┃",
+		"cross":        "┼",
+		"top_left":     "╭",
+		"top_right":    "╮",
+		"bottom_left":  "╰",
+		"bottom_right": "╯",
+		"top_cross":    "┬",
+		"bottom_cross": "┴",
+		"left_cross":   "├",
+		"right_cross":  "┤",
+	}
+	border.RowSeparator = true
+	expected := Theme{
+		Border:      border,
+		Header:      Style{Bold: true, Foreground: ColorBrightWhite, Background: RGBColor(0, 95, 135)},
+		Footer:      Style{Bold: true, Foreground: ColorYellow},
+		BorderColor: Style{Foreground: PaletteColor(244)},
+		Stripes:     []Style{{}, {Background: PaletteColor(236)}},
+	}
+
+	for _, name := range []string{"theme.json", "theme.yaml"} {
+		t.Run(name, func(t *testing.T) {
+			theme, err := LoadTheme(filepath.Join("testdata", name))
+			if err != nil {
+				t.Fatalf("LoadTheme() error: %v", err)
+			}
+			if !reflect.DeepEqual(theme, expected) {
+				t.Errorf("LoadTheme() = %+v, expected %+v", theme, expected)
+			}
+		})
+	}
+
+	// The built-in configuration is not modified
+	if GetBorderConfig(RoundedStyle).Chars["vertical"] != "│" {
+		t.Error("LoadTheme modified the built-in border configuration")
+	}
+}
+
+// TestParseThemeErrors tests that validation errors point at the offending key.
+func TestParseThemeErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		yaml string
+		key  string
+		err  error
+	}{
+		{"unknown section", "colors: {}", "colors", ErrUnknownKey},
+		{"unknown border key", "border:\n  chars:\n    middle_cross: '+'", "border.chars.middle_cross", ErrUnknownKey},
+		{"wide border character", "border:\n  chars:\n    vertical: '｜'", "border.chars.vertical", ErrInvalidValue},
+		{"long border string", "border:\n  chars:\n    cross: '++'", "border.chars.cross", ErrInvalidValue},
+		{"border flag type", "border:\n  top: yes", "border.top", ErrInvalidValue},
+		{"border style", "border:\n  style: fancy", "border.style", ErrInvalidValue},
+		{"csv border style", "border:\n  style: csv", "border.style", ErrInvalidValue},
+		{"html border style", "border:\n  style: html", "border.style", ErrInvalidValue},
+		{"json border style", "border:\n  style: json", "border.style", ErrInvalidValue},
+		{"jsonl border style", "border:\n  style: jsonl", "border.style", ErrInvalidValue},
+		{"yaml border style", "border:\n  style: yaml", "border.style", ErrInvalidValue},
+		{"latex border style", "border:\n  style: latex", "border.style", ErrInvalidValue},
+		{"unknown attribute", "header:\n  blod: true", "header.blod", ErrUnknownKey},
+		{"unknown color", "header:\n  foreground: purple", "header.foreground", ErrInvalidValue},
+		{"color index", "footer:\n  background: 300", "footer.background", ErrInvalidValue},
+		{"stripe", "stripes:\n  - {}\n  - {background: nope}", "stripes[1].background", ErrInvalidValue},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParseThemeYAML([]byte(test.yaml))
+			var themeErr *ThemeError
+			if !errors.As(err, &themeErr) {
+				t.Fatalf("ParseThemeYAML() error = %v, expected a ThemeError", err)
+			}
+			if themeErr.Key != test.key {
+				t.Errorf("ThemeError.Key = %q, expected %q", themeErr.Key, test.key)
+			}
+			if !errors.Is(err, test.err) {
+				t.Errorf("ParseThemeYAML() error = %v, expected %v", err, test.err)
+			}
+		})
+	}
+}
+
+// TestParseYAML tests the YAML subset used by theme files.
+func TestParseYAML(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected any
+	}{
+		{"a: 1\nb: text # comment\nc: '#not a comment'", map[string]any{"a": json.Number("1"), "b": "text", "c": "#not a comment"}},
+		{"list:\n- x\n- \"y\\tz\"\n- [1, true, ~]", map[string]any{"list": []any{"x", "y\tz", []any{json.Number("1"), true, nil}}}},
+		{"items:\n  - name: a\n    value: 'it''s'\n  - {name: b}", map[string]any{"items": []any{
+			map[string]any{"name": "a", "value": "it's"},
+			map[string]any{"name": "b"},
+		}}},
+		{"---\nempty:\nnext: \":\"", map[string]any{"empty": nil, "next": ":"}},
+	}
+
+	for _, test := range tests {
+		result, err := parseYAML([]byte(test.input))
+		if err != nil {
+			t.Errorf("parseYAML(%q) error: %v", test.input, err)
+			continue
+		}
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("parseYAML(%q) = %#v, expected %#v", test.input, result, test.expected)
+		}
+	}
+
+	for _, input := range []string{"a: 1\n  b: 2", "a: [1, 2", "a: 1\na: 2", "stripes: [a}", "stripes: [a: b]", "a: {b: 1]}", "a: {]}"} {
+		if _, err := parseYAML([]byte(input)); err == nil {
+			t.Errorf("parseYAML(%q) should fail", input)
+		}
+	}
+
+	// Malformed flow nodes in theme files are errors rather than hanging the parser
+	for _, input := range []string{"stripes: [a}", "stripes: [a: b]"} {
+		if _, err := ParseThemeYAML([]byte(input)); err == nil {
+			t.Errorf("ParseThemeYAML(%q) should fail", input)
+		}
+	}
+}
@@ -1,16 +1,27 @@
 package termhyo
 
 import (
+	"iter"
 	"regexp"
 	"strings"
 
 	"github.com/rivo/uniseg"
 )
 
+// ansiEscapePattern matches an escape sequence:
+// a CSI sequence such as a color code, an OSC sequence such as a hyperlink terminated by BEL or ST,
+// a DCS, SOS, PM or APC string terminated by ST, or another escape sequence of ESC and a final byte.
+const ansiEscapePattern = `\x1b\[[0-?]*[ -/]*[@-~]` +
+	`|\x1b\][^\x07\x1b]*(?:\x07|\x1b\\)` +
+	`|\x1b[PX^_][^\x1b]*\x1b\\` +
+	`|\x1b[ -/]*[0-~]`
+
 // ANSI escape sequence patterns.
 var (
 	// ANSI color codes and other escape sequences.
-	ansiEscapeRegex = regexp.MustCompile(`\x1b\[[0-9;]*[a-zA-Z]`)
+	ansiEscapeRegex = regexp.MustCompile(ansiEscapePattern)
+	// ANSI escape sequence at the start of a string.
+	ansiEscapePrefixRegex = regexp.MustCompile(`^(?:` + ansiEscapePattern + `)`)
 	// Other control sequences (like \r, \n, \t etc.).
 	controlCharsRegex = regexp.MustCompile(`[\x00-\x1f\x7f]`)
 )
@@ -30,6 +41,11 @@ func stripEscapeSequences(s string) string {
 	return s
 }
 
+// plainText removes the escape sequences from s, keeping the line breaks and other characters.
+func plainText(s string) string {
+	return ansiEscapeRegex.ReplaceAllString(s, "")
+}
+
 // StringWidth returns the display width of a string on terminal.
 // This properly handles multibyte characters, combining characters, emojis, and ANSI escape sequences.
 // This is the public version of stringWidth for external use.
@@ -45,9 +61,22 @@ func stringWidth(s string) int {
 	return uniseg.StringWidth(cleaned)
 }
 
-// truncateString truncates a string to fit within the specified display width.
+// defaultEllipsis is the string that replaces the removed part of truncated content.
+const defaultEllipsis = "..."
+
+// truncateString truncates a string to fit within the specified display width,
+// appending the default ellipsis.
 // Preserves ANSI escape sequences while calculating display width correctly.
 func truncateString(s string, maxWidth int) string {
+	return truncate(s, maxWidth, defaultEllipsis, OverflowTruncate)
+}
+
+// truncate truncates a string to fit within the specified display width.
+// The removed part is replaced by the ellipsis at the end, in the middle or at the start of the string,
+// as given by the overflow policy.
+// The styles and hyperlink active at a cut are closed before the ellipsis and re-opened after it,
+// so that they do not leak into the ellipsis and the borders.
+func truncate(s string, maxWidth int, ellipsis string, position Overflow) string {
 	if maxWidth <= 0 {
 		return ""
 	}
@@ -57,57 +86,46 @@ func truncateString(s string, maxWidth int) string {
 		return s
 	}
 
-	// If we need ellipsis, reserve space for it
-	ellipsisWidth := 3
-	if maxWidth < ellipsisWidth {
-		ellipsisWidth = maxWidth
-		if ellipsisWidth == 0 {
-			return ""
-		}
-	}
-
-	targetWidth := maxWidth - ellipsisWidth
+	// Reserve space for the ellipsis, which is shortened if there is no room for the content
+	targetWidth := maxWidth - stringWidth(ellipsis)
 	if targetWidth <= 0 {
-		return strings.Repeat(".", maxWidth)
+		return truncateWithEscapes(ellipsis, maxWidth)
 	}
 
-	// Split the string to handle escape sequences properly
-	result := truncateWithEscapes(s, targetWidth)
-	return result + "..."
+	switch position {
+	case OverflowTruncateStart:
+		return ellipsis + tailWithEscapes(s, targetWidth)
+	case OverflowTruncateMiddle:
+		return truncateWithEscapes(s, (targetWidth+1)/2) + ellipsis + tailWithEscapes(s, targetWidth/2)
+	default:
+		return truncateWithEscapes(s, targetWidth) + ellipsis
+	}
 }
 
-// truncateWithEscapes truncates a string while preserving escape sequences.
+// truncateWithEscapes returns the head of a string that fits within maxWidth, preserving escape sequences.
+// The styles and hyperlink active at the cut are closed.
 func truncateWithEscapes(s string, maxWidth int) string {
 	if maxWidth <= 0 {
 		return ""
 	}
 
 	var result strings.Builder
+	var state escapeState
 	var currentWidth int
-	gr := uniseg.NewGraphemes(s)
 
-	for gr.Next() {
-		cluster := gr.Str()
-		// Detect ANSI escape sequence
-		if strings.HasPrefix(cluster, "\x1b[") {
+	for cluster, escape := range clusters(s) {
+		// Keep ANSI escape sequences as they are
+		if escape {
 			result.WriteString(cluster)
+			state.update(cluster)
 			continue
 		}
-		// Detect control characters
-		runes := []rune(cluster)
-		if len(runes) == 1 && (runes[0] < 0x20 || runes[0] == 0x7f) {
-			if runes[0] == ' ' || runes[0] == '\t' {
-				if currentWidth >= maxWidth {
-					break
-				}
-				result.WriteString(cluster)
-				currentWidth++
-			}
+		clusterWidth, ok := graphemeWidth(cluster)
+		if !ok {
 			continue
 		}
-		// Normal character
-		clusterWidth := uniseg.StringWidth(cluster)
 		if currentWidth+clusterWidth > maxWidth {
+			result.WriteString(state.close())
 			break
 		}
 		result.WriteString(cluster)
@@ -117,6 +135,323 @@ func truncateWithEscapes(s string, maxWidth int) string {
 	return result.String()
 }
 
+// tailWithEscapes returns the tail of a string that fits within maxWidth, preserving escape sequences.
+// The styles and hyperlink active at the cut are re-opened.
+func tailWithEscapes(s string, maxWidth int) string {
+	type element struct {
+		cluster string
+		escape  bool
+	}
+	var elements []element
+	for cluster, escape := range clusters(s) {
+		elements = append(elements, element{cluster, escape})
+	}
+
+	// Find the first cluster of the tail
+	start := len(elements)
+	currentWidth := 0
+	for i := len(elements) - 1; i >= 0; i-- {
+		if elements[i].escape {
+			continue
+		}
+		clusterWidth, ok := graphemeWidth(elements[i].cluster)
+		if !ok {
+			continue
+		}
+		if currentWidth+clusterWidth > maxWidth {
+			break
+		}
+		currentWidth += clusterWidth
+		start = i
+	}
+
+	var state escapeState
+	for _, e := range elements[:start] {
+		if e.escape {
+			state.update(e.cluster)
+		}
+	}
+
+	var result strings.Builder
+	result.WriteString(state.open())
+	for _, e := range elements[start:] {
+		if _, ok := graphemeWidth(e.cluster); ok || e.escape {
+			result.WriteString(e.cluster)
+		}
+	}
+	return result.String()
+}
+
+// graphemeWidth returns the display width of a grapheme cluster.
+// It reports false for control characters other than tab, which are dropped.
+func graphemeWidth(cluster string) (int, bool) {
+	runes := []rune(cluster)
+	if len(runes) == 1 && (runes[0] < 0x20 || runes[0] == 0x7f) {
+		if runes[0] != '\t' {
+			return 0, false
+		}
+		return 1, true
+	}
+	return uniseg.StringWidth(cluster), true
+}
+
+// clusters iterates over the escape sequences and grapheme clusters of s.
+// The second value reports whether the element is an ANSI escape sequence,
+// which is always yielded as a single unit.
+func clusters(s string) iter.Seq2[string, bool] {
+	return func(yield func(string, bool) bool) {
+		state := -1
+		for len(s) > 0 {
+			if loc := ansiEscapePrefixRegex.FindStringIndex(s); loc != nil {
+				if !yield(s[:loc[1]], true) {
+					return
+				}
+				s = s[loc[1]:]
+				state = -1
+				continue
+			}
+			var cluster string
+			cluster, s, _, state = uniseg.FirstGraphemeClusterInString(s, state)
+			if !yield(cluster, false) {
+				return
+			}
+		}
+	}
+}
+
+// splitLines splits a string into the lines separated by newlines.
+// A trailing carriage return on each line is removed, and ANSI styles are carried over to the following lines.
+func splitLines(s string) []string {
+	if !strings.Contains(s, "\n") {
+		return []string{s}
+	}
+
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSuffix(line, "\r")
+	}
+	return carryEscapes(lines)
+}
+
+// linesWidth returns the display width of the widest line in a string.
+func linesWidth(s string) int {
+	if !strings.Contains(s, "\n") {
+		return stringWidth(s)
+	}
+
+	width := 0
+	for line := range strings.SplitSeq(s, "\n") {
+		width = max(width, stringWidth(line))
+	}
+	return width
+}
+
+// fitString fits a string into the specified display width according to the overflow policy.
+// It returns one line for the truncating policies, with the ellipsis replacing the removed part,
+// and one or more lines for the wrapping policies.
+func fitString(s string, width int, overflow Overflow, ellipsis string) []string {
+	if stringWidth(s) <= width {
+		return []string{s}
+	}
+
+	switch overflow {
+	case OverflowWrap:
+		return wrapString(s, width)
+	case OverflowWordWrap:
+		return wordWrapString(s, width)
+	default:
+		return []string{truncate(s, width, ellipsis, overflow)}
+	}
+}
+
+// wrapString breaks a string into lines of at most width display columns.
+// Lines are broken between grapheme clusters, and ANSI styles are carried over to the following lines.
+func wrapString(s string, width int) []string {
+	return carryEscapes(breakString(s, width))
+}
+
+// wordWrapString breaks a string into lines of at most width display columns at spaces.
+// Words wider than width are broken between grapheme clusters.
+func wordWrapString(s string, width int) []string {
+	var lines []string
+	var line strings.Builder
+	lineWidth := 0
+
+	flush := func() {
+		lines = append(lines, line.String())
+		line.Reset()
+		lineWidth = 0
+	}
+
+	for _, word := range strings.Split(s, " ") {
+		wordWidth := stringWidth(word)
+		if lineWidth > 0 && lineWidth+1+wordWidth > width {
+			flush()
+		}
+
+		// Break words that do not fit on a line by themselves
+		if wordWidth > width {
+			parts := breakString(word, width)
+			for _, part := range parts[:len(parts)-1] {
+				line.WriteString(part)
+				flush()
+			}
+			word = parts[len(parts)-1]
+			wordWidth = stringWidth(word)
+		}
+
+		if lineWidth > 0 {
+			line.WriteString(" ")
+			lineWidth++
+		}
+		line.WriteString(word)
+		lineWidth += wordWidth
+	}
+	flush()
+
+	return carryEscapes(lines)
+}
+
+// breakString breaks a string into lines of at most width display columns
+// without touching the escape sequences.
+func breakString(s string, width int) []string {
+	var lines []string
+	var line strings.Builder
+	lineWidth := 0
+
+	for cluster, escape := range clusters(s) {
+		if escape {
+			line.WriteString(cluster)
+			continue
+		}
+		// Skip control characters other than tab
+		clusterWidth, ok := graphemeWidth(cluster)
+		if !ok {
+			continue
+		}
+		if lineWidth > 0 && lineWidth+clusterWidth > width {
+			lines = append(lines, line.String())
+			line.Reset()
+			lineWidth = 0
+		}
+		line.WriteString(cluster)
+		lineWidth += clusterWidth
+	}
+
+	return append(lines, line.String())
+}
+
+// carryEscapes closes the active SGR escape sequences and OSC 8 hyperlink at the end of each line
+// and re-opens them at the start of the next line,
+// so that styles and links do not leak into borders and are kept on continuation lines.
+func carryEscapes(lines []string) []string {
+	var state escapeState
+	for i, line := range lines {
+		prefix := state.open()
+		for cluster, escape := range clusters(line) {
+			if escape {
+				state.update(cluster)
+			}
+		}
+		lines[i] = prefix + line + state.close()
+	}
+	return lines
+}
+
+// escapeState tracks the SGR escape sequences and OSC 8 hyperlink active in a string.
+type escapeState struct {
+	sgr  []string // SGR escape sequences since the last reset
+	link string   // OSC 8 sequence of the open hyperlink
+}
+
+// update updates the state with an escape sequence.
+func (st *escapeState) update(seq string) {
+	switch {
+	case strings.HasPrefix(seq, "\x1b]8;"):
+		st.link = ""
+		if !isLinkEnd(seq) {
+			st.link = seq
+		}
+	case !strings.HasPrefix(seq, "\x1b[") || !strings.HasSuffix(seq, "m"):
+		// Not an SGR escape sequence
+	case isSGRReset(seq):
+		st.sgr = nil
+	default:
+		st.sgr = append(st.sgr, seq)
+	}
+}
+
+// isSGRReset reports whether an SGR escape sequence resets all attributes,
+// that is, has no parameters or a 0 parameter, as in \x1b[m, \x1b[0m and \x1b[0;1m.
+// Parameters of extended colors such as 38;5;0 are not resets.
+func isSGRReset(seq string) bool {
+	if !strings.HasPrefix(seq, "\x1b[") || !strings.HasSuffix(seq, "m") {
+		return false
+	}
+	params := strings.Split(seq[len("\x1b["):len(seq)-1], ";")
+	for i := 0; i < len(params); i++ {
+		switch strings.TrimLeft(params[i], "0") {
+		case "":
+			return true
+		case "38", "48", "58":
+			if i+1 < len(params) && params[i+1] == "5" {
+				i += 2
+			} else if i+1 < len(params) && params[i+1] == "2" {
+				i += 4
+			}
+		}
+	}
+	return false
+}
+
+// reopenStyle re-opens a style after every SGR reset in s,
+// so that the resets in the content do not end the surrounding style.
+func reopenStyle(s, prefix string) string {
+	if prefix == "" || !strings.Contains(s, "\x1b[") {
+		return s
+	}
+	var b strings.Builder
+	for cluster, escape := range clusters(s) {
+		b.WriteString(cluster)
+		if escape && isSGRReset(cluster) {
+			b.WriteString(prefix)
+		}
+	}
+	return b.String()
+}
+
+// open returns the escape sequences that re-open the active styles and hyperlink.
+func (st *escapeState) open() string {
+	return st.link + strings.Join(st.sgr, "")
+}
+
+// close returns the escape sequences that close the active styles and hyperlink.
+func (st *escapeState) close() string {
+	var s string
+	if len(st.sgr) > 0 {
+		s = AnsiReset
+	}
+	if st.link != "" {
+		s += linkEnd
+	}
+	return s
+}
+
+// linkEnd is the OSC 8 sequence ending a hyperlink.
+const linkEnd = "\x1b]8;;\x1b\\"
+
+// hyperlink returns text as an OSC 8 hyperlink to url.
+func hyperlink(text, url string) string {
+	return "\x1b]8;;" + url + "\x1b\\" + text + linkEnd
+}
+
+// isLinkEnd reports whether an OSC 8 sequence ends a hyperlink, that is, has an empty URI.
+func isLinkEnd(seq string) bool {
+	seq = strings.TrimSuffix(strings.TrimSuffix(seq, "\x07"), "\x1b\\")
+	params := strings.SplitN(seq[len("\x1b]8;"):], ";", 2)
+	return len(params) < 2 || params[1] == ""
+}
+
 // padString pads a string to the specified display width with spaces.
 // Correctly handles ANSI escape sequences when calculating padding.
 func padString(s string, width int, align Alignment) string {
@@ -1,6 +1,7 @@
 package termhyo
 
 import (
+	"slices"
 	"testing"
 )
 
@@ -80,6 +81,26 @@ func TestStringWidthWithEscapeSequences(t *testing.T) {
 			input:    "hello\tworld test",
 			expected: 15, // "hello"(5) + tab(1) + "world test"(10) - 1 = 15
 		},
+		{
+			name:     "OSC 8 hyperlink",
+			input:    "\x1b]8;;https://example.com\x1b\\link\x1b]8;;\x1b\\",
+			expected: 4,
+		},
+		{
+			name:     "OSC terminated by BEL",
+			input:    "\x1b]0;window title\x07hello",
+			expected: 5,
+		},
+		{
+			name:     "DCS string",
+			input:    "\x1bP1$r0m\x1b\\hello",
+			expected: 5,
+		},
+		{
+			name:     "charset selection",
+			input:    "\x1b(Bhello",
+			expected: 5,
+		},
 	}
 
 	for _, tt := range tests {
@@ -123,6 +144,11 @@ func TestStripEscapeSequences(t *testing.T) {
 			input:    "\x1b[38;2;255;0;0mRGB red\x1b[0m",
 			expected: "RGB red",
 		},
+		{
+			name:     "OSC 8 hyperlink",
+			input:    "\x1b]8;id=1;https://example.com/a_b\x1b\\link\x1b]8;;\x1b\\",
+			expected: "link",
+		},
 	}
 
 	for _, tt := range tests {
@@ -164,6 +190,13 @@ func TestTruncateStringWithEscapes(t *testing.T) {
 			expected:  "\x1b[31mhello\x1b[0m...",
 			expectLen: 8,
 		},
+		{
+			name:      "ANSI color closed before ellipsis",
+			input:     "\x1b[31mvery long red text\x1b[0m",
+			maxWidth:  10,
+			expected:  "\x1b[31mvery lo\x1b[0m...",
+			expectLen: 10,
+		},
 		{
 			name:      "ANSI color no truncation needed",
 			input:     "\x1b[31mhello\x1b[0m",
@@ -175,7 +208,7 @@ func TestTruncateStringWithEscapes(t *testing.T) {
 			name:      "multiple ANSI codes with truncation",
 			input:     "\x1b[1;31mhello\x1b[32m world\x1b[0m",
 			maxWidth:  8,
-			expected:  "\x1b[1;31mhello\x1b[32m w...",
+			expected:  "\x1b[1;31mhello\x1b[32m\x1b[0m...",
 			expectLen: 8,
 		},
 		{
@@ -196,7 +229,7 @@ func TestTruncateStringWithEscapes(t *testing.T) {
 			name:      "Japanese with ANSI",
 			input:     "\x1b[31mこんにちは世界\x1b[0m",
 			maxWidth:  10,
-			expected:  "\x1b[31mこんにちは\x1b[0m...",
+			expected:  "\x1b[31mこんに\x1b[0m...",
 			expectLen: 9,
 		},
 	}
@@ -211,7 +244,9 @@ func TestTruncateStringWithEscapes(t *testing.T) {
 					tt.input, tt.maxWidth, resultWidth, tt.expectLen)
 			}
 
-			// Check if result contains expected content (this is more complex due to escape sequences)
+			if result != tt.expected {
+				t.Errorf("truncateString(%q, %d) = %q, expected %q", tt.input, tt.maxWidth, result, tt.expected)
+			}
 			if tt.maxWidth > 0 && resultWidth > tt.maxWidth {
 				t.Errorf("truncateString(%q, %d) resulted in width %d > maxWidth %d",
 					tt.input, tt.maxWidth, resultWidth, tt.maxWidth)
@@ -220,6 +255,88 @@ func TestTruncateStringWithEscapes(t *testing.T) {
 	}
 }
 
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		maxWidth int
+		ellipsis string
+		position Overflow
+		expected string
+	}{
+		{
+			name:     "unicode ellipsis",
+			input:    "hello world",
+			maxWidth: 8,
+			ellipsis: "…",
+			position: OverflowTruncate,
+			expected: "hello w…",
+		},
+		{
+			name:     "no ellipsis",
+			input:    "hello world",
+			maxWidth: 8,
+			ellipsis: "",
+			position: OverflowTruncate,
+			expected: "hello wo",
+		},
+		{
+			name:     "middle",
+			input:    "/usr/local/share/doc",
+			maxWidth: 12,
+			ellipsis: "...",
+			position: OverflowTruncateMiddle,
+			expected: "/usr/.../doc",
+		},
+		{
+			name:     "start",
+			input:    "0123456789abcdef",
+			maxWidth: 8,
+			ellipsis: "…",
+			position: OverflowTruncateStart,
+			expected: "…9abcdef",
+		},
+		{
+			name:     "start re-opens style",
+			input:    "\x1b[31mvery long red\x1b[0m text",
+			maxWidth: 10,
+			ellipsis: "...",
+			position: OverflowTruncateStart,
+			expected: "...\x1b[31med\x1b[0m text",
+		},
+		{
+			name:     "middle closes and re-opens style",
+			input:    "\x1b[31mvery long red text\x1b[0m",
+			maxWidth: 10,
+			ellipsis: "...",
+			position: OverflowTruncateMiddle,
+			expected: "\x1b[31mvery\x1b[0m...\x1b[31mext\x1b[0m",
+		},
+		{
+			name:     "ellipsis wider than width",
+			input:    "hello",
+			maxWidth: 2,
+			ellipsis: "...",
+			position: OverflowTruncateMiddle,
+			expected: "..",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := truncate(tt.input, tt.maxWidth, tt.ellipsis, tt.position)
+			if result != tt.expected {
+				t.Errorf("truncate(%q, %d, %q, %q) = %q, expected %q",
+					tt.input, tt.maxWidth, tt.ellipsis, tt.position, result, tt.expected)
+			}
+			if width := stringWidth(result); width > tt.maxWidth {
+				t.Errorf("truncate(%q, %d, %q, %q) resulted in width %d > maxWidth %d",
+					tt.input, tt.maxWidth, tt.ellipsis, tt.position, width, tt.maxWidth)
+			}
+		})
+	}
+}
+
 func TestPadStringWithEscapes(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -299,6 +416,133 @@ func TestPadStringWithEscapes(t *testing.T) {
 	}
 }
 
+func TestFitString(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		width    int
+		overflow Overflow
+		expected []string
+	}{
+		{
+			name:     "fits",
+			input:    "hello",
+			width:    10,
+			overflow: OverflowWrap,
+			expected: []string{"hello"},
+		},
+		{
+			name:     "truncate",
+			input:    "hello world",
+			width:    8,
+			overflow: OverflowTruncate,
+			expected: []string{"hello..."},
+		},
+		{
+			name:     "wrap",
+			input:    "hello world",
+			width:    4,
+			overflow: OverflowWrap,
+			expected: []string{"hell", "o wo", "rld"},
+		},
+		{
+			name:     "word wrap",
+			input:    "hello big world",
+			width:    9,
+			overflow: OverflowWordWrap,
+			expected: []string{"hello big", "world"},
+		},
+		{
+			name:     "word wrap long word",
+			input:    "a verylongword",
+			width:    5,
+			overflow: OverflowWordWrap,
+			expected: []string{"a", "veryl", "ongwo", "rd"},
+		},
+		{
+			name:     "wrap wide characters",
+			input:    "こんにちは",
+			width:    5,
+			overflow: OverflowWrap,
+			expected: []string{"こん", "にち", "は"},
+		},
+		{
+			name:     "wrap carries ANSI style",
+			input:    "\x1b[31mhello world\x1b[0m",
+			width:    6,
+			overflow: OverflowWordWrap,
+			expected: []string{"\x1b[31mhello\x1b[0m", "\x1b[31mworld\x1b[0m"},
+		},
+		{
+			name:     "wrap carries hyperlink",
+			input:    "\x1b]8;;https://example.com\x1b\\hello world\x1b]8;;\x1b\\",
+			width:    6,
+			overflow: OverflowWordWrap,
+			expected: []string{
+				"\x1b]8;;https://example.com\x1b\\hello\x1b]8;;\x1b\\",
+				"\x1b]8;;https://example.com\x1b\\world\x1b]8;;\x1b\\",
+			},
+		},
+		{
+			name:     "truncate keeps hyperlink whole",
+			input:    "\x1b]8;;https://example.com\x1b\\hello world\x1b]8;;\x1b\\",
+			width:    8,
+			overflow: OverflowTruncate,
+			expected: []string{"\x1b]8;;https://example.com\x1b\\hello\x1b]8;;\x1b\\..."},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := fitString(tt.input, tt.width, tt.overflow, defaultEllipsis)
+			if !slices.Equal(result, tt.expected) {
+				t.Errorf("fitString(%q, %d, %q) = %q, expected %q",
+					tt.input, tt.width, tt.overflow, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSplitLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+		width    int
+	}{
+		{
+			name:     "single line",
+			input:    "hello",
+			expected: []string{"hello"},
+			width:    5,
+		},
+		{
+			name:     "multiple lines",
+			input:    "hello\nbig\r\nworld!",
+			expected: []string{"hello", "big", "world!"},
+			width:    6,
+		},
+		{
+			name:     "ANSI style across lines",
+			input:    "\x1b[31mred\ntext\x1b[0m",
+			expected: []string{"\x1b[31mred\x1b[0m", "\x1b[31mtext\x1b[0m"},
+			width:    4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := splitLines(tt.input)
+			if !slices.Equal(result, tt.expected) {
+				t.Errorf("splitLines(%q) = %q, expected %q", tt.input, result, tt.expected)
+			}
+			if width := linesWidth(tt.input); width != tt.width {
+				t.Errorf("linesWidth(%q) = %d, expected %d", tt.input, width, tt.width)
+			}
+		})
+	}
+}
+
 // Benchmark tests to ensure performance is acceptable.
 func BenchmarkStringWidth(b *testing.B) {
 	testStrings := []string{
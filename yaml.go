@@ -0,0 +1,424 @@
+package termhyo
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// This file implements the subset of YAML used by theme files:
+// block mappings and sequences, flow mappings and sequences,
+// plain, single-quoted and double-quoted scalars, and comments.
+// Anchors, tags, multi-document streams and block scalars are not supported.
+//
+// Values are decoded as map[string]any, []any, string, bool, json.Number or nil,
+// the same types as encoding/json with UseNumber.
+
+// yamlNumberRegex matches plain scalars resolved as numbers.
+var yamlNumberRegex = regexp.MustCompile(`^[-+]?(\d+(\.\d*)?|\.\d+)([eE][-+]?\d+)?$`)
+
+// yamlLine is a non-empty line of a YAML document without its comment.
+type yamlLine struct {
+	num    int    // line number, starting at 1
+	indent int    // number of leading spaces
+	text   string // content after the indentation
+}
+
+// yamlParser parses the lines of a YAML document.
+type yamlParser struct {
+	lines []yamlLine
+	pos   int
+}
+
+// parseYAML parses a YAML document.
+func parseYAML(data []byte) (any, error) {
+	p := &yamlParser{}
+	for i, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimRight(stripYAMLComment(strings.TrimRight(line, "\r")), " \t")
+		text := strings.TrimLeft(line, " ")
+		if text == "" || text == "---" {
+			continue
+		}
+		if strings.HasPrefix(text, "\t") {
+			return nil, fmt.Errorf("yaml: line %d: tabs are not allowed for indentation", i+1)
+		}
+		p.lines = append(p.lines, yamlLine{num: i + 1, indent: len(line) - len(text), text: text})
+	}
+	if len(p.lines) == 0 {
+		return nil, nil
+	}
+
+	value, err := p.parseBlock(p.lines[0].indent)
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.lines) {
+		return nil, fmt.Errorf("yaml: line %d: unexpected indentation", p.lines[p.pos].num)
+	}
+	return value, nil
+}
+
+// stripYAMLComment removes a comment starting with '#' at the beginning of the line
+// or after a space, outside quoted scalars.
+func stripYAMLComment(line string) string {
+	var quote byte
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case quote == '"' && c == '\\':
+			i++ // Skip the escaped character
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '#' && (i == 0 || line[i-1] == ' ' || line[i-1] == '\t'):
+			return line[:i]
+		}
+	}
+	return line
+}
+
+// isSequenceItem reports whether the line text starts a block sequence item.
+func isSequenceItem(text string) bool {
+	return text == "-" || strings.HasPrefix(text, "- ")
+}
+
+// parseBlock parses the block node starting at the current line with the given indentation.
+func (p *yamlParser) parseBlock(indent int) (any, error) {
+	line := p.lines[p.pos]
+	if isSequenceItem(line.text) {
+		return p.parseSequence(indent)
+	}
+	if _, _, ok := splitYAMLKey(line.text); !ok {
+		// A single scalar or flow node
+		p.pos++
+		return parseYAMLValue(line.text, line.num)
+	}
+	return p.parseMapping(indent)
+}
+
+// parseMapping parses a block mapping whose keys have the given indentation.
+func (p *yamlParser) parseMapping(indent int) (map[string]any, error) {
+	result := make(map[string]any)
+	for p.pos < len(p.lines) {
+		line := p.lines[p.pos]
+		if line.indent < indent {
+			break
+		}
+		if line.indent > indent {
+			return nil, fmt.Errorf("yaml: line %d: unexpected indentation", line.num)
+		}
+		if isSequenceItem(line.text) {
+			break
+		}
+		key, rest, ok := splitYAMLKey(line.text)
+		if !ok {
+			return nil, fmt.Errorf("yaml: line %d: expected a mapping key", line.num)
+		}
+		if _, dup := result[key]; dup {
+			return nil, fmt.Errorf("yaml: line %d: duplicate key %q", line.num, key)
+		}
+		p.pos++
+
+		value, err := p.parseMappingValue(indent, rest, line.num)
+		if err != nil {
+			return nil, err
+		}
+		result[key] = value
+	}
+	return result, nil
+}
+
+// parseMappingValue parses the value of a mapping key at the given indentation.
+// An empty value is followed by a nested block, or a sequence at the same indentation.
+func (p *yamlParser) parseMappingValue(indent int, rest string, num int) (any, error) {
+	if rest != "" {
+		return parseYAMLValue(rest, num)
+	}
+	if p.pos >= len(p.lines) {
+		return nil, nil
+	}
+	next := p.lines[p.pos]
+	switch {
+	case next.indent > indent:
+		return p.parseBlock(next.indent)
+	case next.indent == indent && isSequenceItem(next.text):
+		return p.parseSequence(indent)
+	default:
+		return nil, nil
+	}
+}
+
+// parseSequence parses a block sequence whose items have the given indentation.
+func (p *yamlParser) parseSequence(indent int) ([]any, error) {
+	result := make([]any, 0)
+	for p.pos < len(p.lines) {
+		line := p.lines[p.pos]
+		if line.indent != indent || !isSequenceItem(line.text) {
+			if line.indent > indent {
+				return nil, fmt.Errorf("yaml: line %d: unexpected indentation", line.num)
+			}
+			break
+		}
+
+		rest := strings.TrimLeft(line.text[1:], " ")
+		if rest == "" {
+			// The item is a nested block on the following lines
+			p.pos++
+			if p.pos >= len(p.lines) || p.lines[p.pos].indent <= indent {
+				result = append(result, nil)
+				continue
+			}
+			value, err := p.parseBlock(p.lines[p.pos].indent)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, value)
+			continue
+		}
+
+		// The item starts on the same line; parse it as if it were indented on its own line
+		p.lines[p.pos].indent = indent + len(line.text) - len(rest)
+		p.lines[p.pos].text = rest
+		value, err := p.parseBlock(p.lines[p.pos].indent)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, value)
+	}
+	return result, nil
+}
+
+// splitYAMLKey splits a mapping entry into its key and the rest of the line.
+func splitYAMLKey(text string) (key, rest string, ok bool) {
+	if text == "" || strings.ContainsRune("[{", rune(text[0])) {
+		return "", "", false
+	}
+	if text[0] == '"' || text[0] == '\'' {
+		end := quotedEnd(text)
+		if end < 0 || end >= len(text) || text[end] != ':' {
+			return "", "", false
+		}
+		key, err := unquoteYAML(text[:end])
+		if err != nil {
+			return "", "", false
+		}
+		rest = text[end+1:]
+		if rest != "" && rest[0] != ' ' {
+			return "", "", false
+		}
+		return key, strings.TrimSpace(rest), true
+	}
+	for i := 0; i < len(text); i++ {
+		if text[i] == ':' && (i == len(text)-1 || text[i+1] == ' ') {
+			return strings.TrimSpace(text[:i]), strings.TrimSpace(text[i+1:]), true
+		}
+	}
+	return "", "", false
+}
+
+// quotedEnd returns the index after the closing quote of a quoted scalar at the start of s, or -1.
+func quotedEnd(s string) int {
+	quote := s[0]
+	for i := 1; i < len(s); i++ {
+		switch {
+		case quote == '"' && s[i] == '\\':
+			i++
+		case s[i] == quote:
+			if quote == '\'' && i+1 < len(s) && s[i+1] == '\'' {
+				i++ // Escaped single quote
+				continue
+			}
+			return i + 1
+		}
+	}
+	return -1
+}
+
+// unquoteYAML returns the value of a single-quoted or double-quoted scalar.
+func unquoteYAML(s string) (string, error) {
+	if s[0] == '\'' {
+		return strings.ReplaceAll(s[1:len(s)-1], "''", "'"), nil
+	}
+	return strconv.Unquote(s)
+}
+
+// parseYAMLValue parses a scalar or flow node on a single line.
+func parseYAMLValue(text string, num int) (any, error) {
+	f := &yamlFlow{text: text}
+	value, err := f.parseValue()
+	if err == nil {
+		f.skipSpaces()
+		if f.pos < len(f.text) {
+			err = fmt.Errorf("unexpected %q", f.text[f.pos:])
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("yaml: line %d: %w", num, err)
+	}
+	return value, nil
+}
+
+// resolveYAMLScalar returns the value of a plain scalar.
+func resolveYAMLScalar(s string) any {
+	switch s {
+	case "", "~", "null", "Null", "NULL":
+		return nil
+	case "true", "True", "TRUE":
+		return true
+	case "false", "False", "FALSE":
+		return false
+	}
+	if yamlNumberRegex.MatchString(s) {
+		return json.Number(s)
+	}
+	return s
+}
+
+// yamlFlow parses flow nodes and scalars on a single line.
+type yamlFlow struct {
+	text  string
+	pos   int
+	depth int // nesting level of flow nodes
+}
+
+func (f *yamlFlow) skipSpaces() {
+	for f.pos < len(f.text) && f.text[f.pos] == ' ' {
+		f.pos++
+	}
+}
+
+// parseValue parses a flow mapping, flow sequence or scalar.
+func (f *yamlFlow) parseValue() (any, error) {
+	f.skipSpaces()
+	if f.pos >= len(f.text) {
+		return nil, nil
+	}
+	switch f.text[f.pos] {
+	case '{':
+		return f.parseMapping()
+	case '[':
+		return f.parseSequence()
+	case '"', '\'':
+		end := quotedEnd(f.text[f.pos:])
+		if end < 0 {
+			return nil, fmt.Errorf("unterminated quoted scalar")
+		}
+		s, err := unquoteYAML(f.text[f.pos : f.pos+end])
+		if err != nil {
+			return nil, fmt.Errorf("invalid quoted scalar %s", f.text[f.pos:f.pos+end])
+		}
+		f.pos += end
+		return s, nil
+	}
+	return resolveYAMLScalar(f.parsePlain()), nil
+}
+
+// parsePlain parses a plain scalar, ending at a flow indicator inside flow nodes.
+func (f *yamlFlow) parsePlain() string {
+	start := f.pos
+	for f.depth > 0 && f.pos < len(f.text) {
+		c := f.text[f.pos]
+		if c == ',' || c == ']' || c == '}' {
+			break
+		}
+		if c == ':' && (f.pos+1 == len(f.text) || f.text[f.pos+1] == ' ') {
+			break
+		}
+		f.pos++
+	}
+	if f.depth == 0 {
+		f.pos = len(f.text)
+	}
+	return strings.TrimSpace(f.text[start:f.pos])
+}
+
+// parseMapping parses a flow mapping such as {bold: true}.
+func (f *yamlFlow) parseMapping() (map[string]any, error) {
+	result := make(map[string]any)
+	f.pos++ // '{'
+	f.depth++
+	defer func() { f.depth-- }()
+	for {
+		f.skipSpaces()
+		if f.pos >= len(f.text) {
+			return nil, fmt.Errorf("unterminated flow mapping")
+		}
+		if f.text[f.pos] == '}' {
+			f.pos++
+			return result, nil
+		}
+		start := f.pos
+		key, err := f.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		if f.pos == start {
+			return nil, fmt.Errorf("unexpected %q in flow mapping", f.text[f.pos:])
+		}
+		f.skipSpaces()
+		if f.pos >= len(f.text) || f.text[f.pos] != ':' {
+			return nil, fmt.Errorf("expected ':' in flow mapping")
+		}
+		f.pos++
+		value, err := f.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		result[fmt.Sprint(key)] = value
+		if err := f.skipSeparator('}'); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// parseSequence parses a flow sequence such as [1, 2].
+func (f *yamlFlow) parseSequence() ([]any, error) {
+	result := make([]any, 0)
+	f.pos++ // '['
+	f.depth++
+	defer func() { f.depth-- }()
+	for {
+		f.skipSpaces()
+		if f.pos >= len(f.text) {
+			return nil, fmt.Errorf("unterminated flow sequence")
+		}
+		if f.text[f.pos] == ']' {
+			f.pos++
+			return result, nil
+		}
+		start := f.pos
+		value, err := f.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		if f.pos == start {
+			return nil, fmt.Errorf("unexpected %q in flow sequence", f.text[f.pos:])
+		}
+		result = append(result, value)
+		if err := f.skipSeparator(']'); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// skipSeparator skips the ',' after an element of a flow node.
+// The element must be followed by ',' or the closing bracket, which is left for the caller.
+func (f *yamlFlow) skipSeparator(closing byte) error {
+	f.skipSpaces()
+	switch {
+	case f.pos >= len(f.text):
+		return nil // Reported as unterminated by the caller
+	case f.text[f.pos] == ',':
+		f.pos++
+		return nil
+	case f.text[f.pos] == closing:
+		return nil
+	default:
+		return fmt.Errorf("expected ',' or '%c', got %q", closing, f.text[f.pos:])
+	}
+}
@@ -0,0 +1,172 @@
+package termhyo
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// yamlAmbiguousRegex matches plain scalars that some YAML parsers resolve to a type other than string:
+// the booleans, value (=) and merge (<<) keys of YAML 1.1 and anything starting like a number,
+// such as dates, hexadecimal numbers and .inf.
+var yamlAmbiguousRegex = regexp.MustCompile(`(?i)^(y|n|yes|no|on|off|=|<<|[-+]?\.?[0-9].*|[-+]?\.(inf|nan))$`)
+
+// YAMLRenderer writes the table as a YAML sequence of mappings, one per row, keyed by the column titles.
+// Each row is written as soon as it is added, and footer rows are written by Render.
+type YAMLRenderer struct {
+	rendered bool
+	rowCount int
+}
+
+// AddRow writes a row as a mapping in the sequence.
+func (r *YAMLRenderer) AddRow(table *Table, row Row) error {
+	if r.rendered {
+		return ErrAddAfterRender
+	}
+	if len(table.columns) == 0 {
+		return ErrNoColumns
+	}
+	r.rowCount++
+	_, err := table.writer.Write([]byte(yamlMapping(table, row)))
+	return err
+}
+
+// Render writes the footer rows, or an empty sequence if the table has no rows.
+func (r *YAMLRenderer) Render(table *Table) error {
+	if r.rendered {
+		return ErrTableAlreadyRendered
+	}
+	if len(table.columns) == 0 {
+		return ErrNoColumns
+	}
+	for _, footer := range table.footers {
+		if err := r.AddRow(table, footer); err != nil {
+			return err
+		}
+	}
+	if r.rowCount == 0 {
+		if _, err := table.writer.Write([]byte("[]\n")); err != nil {
+			return err
+		}
+	}
+	r.rendered = true
+	return nil
+}
+
+// IsRendered returns whether the table has been rendered.
+func (r *YAMLRenderer) IsRendered() bool {
+	return r.rendered
+}
+
+// yamlMapping returns a row as an item of a block sequence with the keys in column order.
+func yamlMapping(table *Table, row Row) string {
+	keys := recordKeys(table)
+	var b strings.Builder
+	for i, field := range plainRecord(table, row) {
+		if i == 0 {
+			b.WriteString("- ")
+		} else {
+			b.WriteString("  ")
+		}
+		col := table.columns[i]
+		b.WriteString(yamlScalar(keys[i]) + ":")
+
+		value, ok := typedValue(field, col.Type)
+		switch {
+		case ok && value == "":
+			b.WriteString(" null\n")
+		case ok && col.Type == TypeNumber:
+			b.WriteString(" " + yamlNumber(value) + "\n")
+		case ok:
+			b.WriteString(" " + value + "\n")
+		// Values of only line breaks are double-quoted, since a block scalar without content lines loses them
+		case strings.Trim(field, "\n") != "" && strings.Contains(field, "\n") && isYAMLPrintable(field):
+			b.WriteString(" " + yamlBlockScalar(field, "  "))
+		default:
+			b.WriteString(" " + yamlScalar(field) + "\n")
+		}
+	}
+	return b.String()
+}
+
+// yamlNumber returns a JSON number in a form that both YAML 1.1 and YAML 1.2 read as a number.
+// YAML 1.1 requires a decimal point in floats and a sign in exponents, so 1e3 is written as 1.0e+3.
+func yamlNumber(s string) string {
+	m := jsonNumberRegex.FindStringSubmatch(s)
+	if m == nil || m[3] == "" {
+		return s // Integers and floats without exponents are the same in both versions
+	}
+	integer, fraction, exponent := strings.TrimSuffix(s, m[2]+m[3]), m[2], m[3][1:]
+	if fraction == "" {
+		fraction = ".0"
+	}
+	if exponent[0] != '+' && exponent[0] != '-' {
+		exponent = "+" + exponent
+	}
+	return integer + fraction + "e" + exponent
+}
+
+// yamlScalar returns s as a plain scalar if it would be read back as the same string,
+// and as a quoted scalar otherwise.
+func yamlScalar(s string) string {
+	switch {
+	case !isYAMLPrintable(s) || strings.ContainsAny(s, "\n\t"):
+		return strconv.Quote(s) // Go escapes are a subset of the YAML double-quoted escapes
+	case yamlNeedsQuotes(s):
+		return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+	default:
+		return s
+	}
+}
+
+// yamlNeedsQuotes reports whether a single-line string cannot be written as a plain scalar,
+// because it would be read as another type, or it contains indicators of YAML syntax.
+func yamlNeedsQuotes(s string) bool {
+	if s == "" || resolveYAMLScalar(s) != any(s) || yamlAmbiguousRegex.MatchString(s) {
+		return true
+	}
+	if strings.ContainsRune("-?:,[]{}#&*!|>'\"%@` ", rune(s[0])) || strings.HasSuffix(s, " ") {
+		return true
+	}
+	return strings.Contains(s, ": ") || strings.Contains(s, " #") || strings.HasSuffix(s, ":")
+}
+
+// yamlBlockScalar returns a multi-line string as a literal block scalar,
+// the value of a mapping key indented by parent, with the lines indented two more spaces.
+// The chomping indicator keeps the trailing line breaks exactly,
+// and an indentation indicator is added if the first non-empty line starts with a space.
+// The indicator is relative to the indentation of the parent mapping.
+func yamlBlockScalar(s, parent string) string {
+	const step = 2
+	indent := parent + strings.Repeat(" ", step)
+	header := "|"
+	if strings.HasPrefix(strings.TrimLeft(s, "\n"), " ") {
+		header += strconv.Itoa(step)
+	}
+	switch trimmed := strings.TrimRight(s, "\n"); {
+	case trimmed == s:
+		header += "-"
+	case len(s)-len(trimmed) > 1:
+		header += "+"
+	}
+
+	var b strings.Builder
+	b.WriteString(header + "\n")
+	for line := range strings.Lines(s) {
+		line = strings.TrimSuffix(line, "\n")
+		if line != "" {
+			b.WriteString(indent + line)
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// isYAMLPrintable reports whether a string has no control characters other than tab and line feed,
+// which must be escaped in double-quoted scalars, and none of the line breaks of YAML 1.1
+// (U+0085, U+2028 and U+2029), which YAML 1.1 parsers would read as the end of a line.
+func isYAMLPrintable(s string) bool {
+	return !strings.ContainsFunc(s, func(r rune) bool {
+		return (r < 0x20 && r != '\t' && r != '\n') || r == 0x7f || r == 0x85 || r == 0x2028 || r == 0x2029
+	})
+}